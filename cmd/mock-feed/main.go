@@ -19,14 +19,23 @@ func main() {
 				{
 					"id":        "101",
 					"headline":  "Dummy News 1",
+					"author":    "Jane Doe and John Smith",
+					"license":   "all-rights-reserved",
 					"content":   "This is a dummy article content from the mock server.",
 					"timestamp": time.Now().Format(time.RFC3339),
+					"media": []map[string]interface{}{
+						{"type": "image", "url": "http://mock-feed:8081/images/101.jpg", "width": 1200, "height": 675, "caption": "Dummy image", "credit": "Mock Feed"},
+					},
 				},
 				{
 					"id":        "102",
 					"headline":  "Dummy News 2",
 					"content":   "Another dummy article.",
 					"timestamp": time.Now().Add(-1 * time.Hour).Format(time.RFC3339),
+					"media": []map[string]interface{}{
+						{"type": "video", "url": "http://mock-feed:8081/videos/102.mp4", "duration": 95,
+							"thumbnails": []map[string]interface{}{{"url": "http://mock-feed:8081/images/102.jpg", "width": 640, "height": 360}}},
+					},
 				},
 			},
 		}
@@ -0,0 +1,187 @@
+package factory
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/classifier"
+	"github.com/SportsNewsCrawler/internal/infra/enricher"
+	"github.com/SportsNewsCrawler/internal/infra/gazetteer"
+	"github.com/SportsNewsCrawler/internal/infra/repository"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+	"github.com/SportsNewsCrawler/pkg/config"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
+)
+
+// NewSanitizeEnricher creates the HTML sanitization enricher.
+func NewSanitizeEnricher(cfg *config.Config) domain.Enricher {
+	return enricher.NewSanitizeEnricher(textproc.DefaultPolicy(), cfg.Enrichment.ReadingWordsPerMinute)
+}
+
+// NewLanguageEnricher creates the offline language detection enricher.
+func NewLanguageEnricher() domain.Enricher {
+	return enricher.NewLanguageEnricher()
+}
+
+// NewResultsEnricher creates the match result extractor.
+func NewResultsEnricher() domain.Enricher {
+	return enricher.NewResultsEnricher()
+}
+
+// NewSummaryEnricher creates the extractive summary and keyword enricher.
+func NewSummaryEnricher(cfg *config.Config) domain.Enricher {
+	return enricher.NewSummaryEnricher(cfg.Enrichment.SummarySentences, cfg.Enrichment.Keywords)
+}
+
+// NewEnrichmentChain registers every enricher provided to the "enrichers" group
+// and builds the default chain from ENRICHERS.
+func NewEnrichmentChain(enrichers []domain.Enricher, cfg *config.Config) (*app.EnrichmentChain, error) {
+	defaults := make([]app.EnrichmentStep, 0, len(cfg.Enrichment.Defaults))
+	for _, name := range cfg.Enrichment.Defaults {
+		defaults = append(defaults, app.EnrichmentStep{Name: name, OnError: app.FailureSkip})
+	}
+	return app.NewEnrichmentChain(enrichers, defaults)
+}
+
+// newEnrichmentSteps converts a source's enricher config, preserving nil so the
+// source falls back to the default chain.
+func newEnrichmentSteps(enrichers []config.EnricherConfig) []app.EnrichmentStep {
+	if enrichers == nil {
+		return nil
+	}
+	steps := make([]app.EnrichmentStep, 0, len(enrichers))
+	for _, e := range enrichers {
+		steps = append(steps, app.EnrichmentStep{Name: e.Name, OnError: app.FailurePolicy(e.OnError)})
+	}
+	return steps
+}
+
+// NewGazetteerSource creates the entity gazetteer source selected by GAZETTEER_SOURCE.
+func NewGazetteerSource(client *mongo.Client, cfg *config.Config) (domain.GazetteerSource, error) {
+	g := cfg.Enrichment.Gazetteer
+	if g.Source == "mongo" {
+		return repository.NewMongoGazetteerRepository(client, cfg.MongoDBName, g.Collection)
+	}
+	return gazetteer.NewFileSource(g.FilePath), nil
+}
+
+// NewEntityEnricher creates the gazetteer entity enricher and, when configured,
+// reloads the gazetteer periodically so edits are picked up without a restart.
+func NewEntityEnricher(lc fx.Lifecycle, source domain.GazetteerSource, cfg *config.Config) (domain.Enricher, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	e, err := enricher.NewEntityEnricher(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := cfg.Enrichment.Gazetteer.RefreshInterval
+	if interval > 0 {
+		runCtx, stop := context.WithCancel(context.Background())
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error {
+				go refreshPeriodically(runCtx, interval, "gazetteer", e.Reload)
+				return nil
+			},
+			OnStop: func(_ context.Context) error {
+				stop()
+				return nil
+			},
+		})
+	}
+	return e, nil
+}
+
+// NewTaxonomyStore creates the Mongo-backed tag taxonomy store.
+func NewTaxonomyStore(client *mongo.Client, cfg *config.Config) (domain.TaxonomyStore, error) {
+	return repository.NewMongoTaxonomyRepository(client, cfg.MongoDBName)
+}
+
+// NewTaxonomyEnricher creates the tag taxonomy enricher. Unmapped tags are
+// flushed and mappings reloaded periodically, and flushed once more on shutdown.
+func NewTaxonomyEnricher(lc fx.Lifecycle, store domain.TaxonomyStore, cfg *config.Config) (*enricher.TaxonomyEnricher, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	e, err := enricher.NewTaxonomyEnricher(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	runCtx, stop := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			if interval := cfg.Enrichment.Taxonomy.RefreshInterval; interval > 0 {
+				go refreshPeriodically(runCtx, interval, "taxonomy", e.Refresh)
+			}
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			stop()
+			return e.Flush(ctx)
+		},
+	})
+	return e, nil
+}
+
+// NewClassifierEnricher creates the sport and category classifier. Its rules
+// file is reloaded periodically so edits are picked up without a restart.
+func NewClassifierEnricher(lc fx.Lifecycle, cfg *config.Config) (*enricher.ClassifierEnricher, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c := cfg.Enrichment.Classify
+	e, err := enricher.NewClassifierEnricher(ctx, classifier.NewFileSource(c.RulesFile), c.MinScore)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.RefreshInterval > 0 {
+		runCtx, stop := context.WithCancel(context.Background())
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error {
+				go refreshPeriodically(runCtx, c.RefreshInterval, "classifier", e.Reload)
+				return nil
+			},
+			OnStop: func(_ context.Context) error {
+				stop()
+				return nil
+			},
+		})
+	}
+	return e, nil
+}
+
+// AsClassifierEnricher registers the classifier in the enrichment chain.
+func AsClassifierEnricher(e *enricher.ClassifierEnricher) domain.Enricher {
+	return e
+}
+
+// AsTaxonomyEnricher registers the taxonomy enricher in the enrichment chain.
+func AsTaxonomyEnricher(e *enricher.TaxonomyEnricher) domain.Enricher {
+	return e
+}
+
+// refreshPeriodically calls reload every interval until ctx is cancelled.
+func refreshPeriodically(ctx context.Context, interval time.Duration, name string, reload func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := reload(ctx); err != nil {
+				slog.Error("Failed to reload, keeping previous version", "component", name, "error", err)
+				continue
+			}
+			slog.Debug("Reloaded", "component", name)
+		}
+	}
+}
@@ -72,6 +72,42 @@ func NewDLQProducer(cfg *config.Config, lc fx.Lifecycle) (*queue.KafkaProducer,
 	return producer, nil
 }
 
+// NewStoryKafkaProducer creates the Kafka producer for story events.
+func NewStoryKafkaProducer(cfg *config.Config, lc fx.Lifecycle) (*queue.KafkaProducer, error) {
+	if len(cfg.KafkaBrokers) == 0 {
+		return nil, errors.New("kafka brokers not configured")
+	}
+	if cfg.Stories.Topic == "" {
+		return nil, errors.New("kafka story topic not configured")
+	}
+
+	producer := queue.NewKafkaProducer(cfg.KafkaBrokers, cfg.Stories.Topic)
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return producer.Close()
+		},
+	})
+	return producer, nil
+}
+
+// NewMatchKafkaProducer creates the Kafka producer for match events.
+func NewMatchKafkaProducer(cfg *config.Config, lc fx.Lifecycle) (*queue.KafkaProducer, error) {
+	if len(cfg.KafkaBrokers) == 0 {
+		return nil, errors.New("kafka brokers not configured")
+	}
+	if cfg.Matches.Topic == "" {
+		return nil, errors.New("kafka match topic not configured")
+	}
+
+	producer := queue.NewKafkaProducer(cfg.KafkaBrokers, cfg.Matches.Topic)
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return producer.Close()
+		},
+	})
+	return producer, nil
+}
+
 // NewKafkaConsumer creates a Kafka consumer with DLQ support.
 func NewKafkaConsumer(
 	cfg *config.Config,
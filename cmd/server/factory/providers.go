@@ -19,6 +19,9 @@ func NewProviders(cfg *config.Config) ([]domain.Provider, error) {
 
 	var providers []domain.Provider
 	for _, source := range cfg.Sources {
+		if source.IsMatchSource() {
+			continue
+		}
 		tr, err := transformer.GetTransformer(source.Transformer)
 		if err != nil {
 			slog.Warn("Skipping source", "source", source.Name, "error", err)
@@ -35,3 +38,22 @@ func NewProviders(cfg *config.Config) ([]domain.Provider, error) {
 	}
 	return providers, nil
 }
+
+// NewMatchProviders creates the configured fixtures and results providers.
+func NewMatchProviders(cfg *config.Config) []domain.MatchProvider {
+	var providers []domain.MatchProvider
+	for _, source := range cfg.Sources {
+		if !source.IsMatchSource() {
+			continue
+		}
+		tr, err := transformer.GetMatchTransformer(source.Transformer)
+		if err != nil {
+			slog.Warn("Skipping source", "source", source.Name, "error", err)
+			continue
+		}
+
+		providers = append(providers, provider.NewGenericMatchProvider(source.Name, source.URL, tr, source.Pagination))
+		slog.Info("Registered match provider", "provider", source.Name, "transformer", source.Transformer)
+	}
+	return providers
+}
@@ -1,20 +1,28 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/SportsNewsCrawler/internal/app"
 	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/enricher"
 	"github.com/SportsNewsCrawler/internal/infra/gateway"
+	"github.com/SportsNewsCrawler/internal/infra/linkcheck"
 	"github.com/SportsNewsCrawler/internal/infra/queue"
 	"github.com/SportsNewsCrawler/internal/infra/repository"
+	"github.com/SportsNewsCrawler/internal/infra/urlcanon"
 	"github.com/SportsNewsCrawler/pkg/config"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/fx"
 )
 
 // NewMongoRepository creates a MongoDB repository.
-func NewMongoRepository(client *mongo.Client, cfg *config.Config) (domain.Repository, error) {
+func NewMongoRepository(client *mongo.Client, cfg *config.Config) (*repository.MongoRepository, error) {
 	if cfg.MongoDBName == "" {
 		return nil, errors.New("mongo database name not configured")
 	}
@@ -24,24 +32,288 @@ func NewMongoRepository(client *mongo.Client, cfg *config.Config) (domain.Reposi
 	return repository.NewMongoRepository(client, cfg.MongoDBName, cfg.MongoColl)
 }
 
+// NewQuarantineRepository creates the MongoDB store for articles failing validation.
+func NewQuarantineRepository(client *mongo.Client, cfg *config.Config) (domain.QuarantineWriter, error) {
+	if cfg.Validation.QuarantineCollection == "" {
+		return nil, errors.New("mongo quarantine collection name not configured")
+	}
+	return repository.NewMongoQuarantineRepository(client, cfg.MongoDBName, cfg.Validation.QuarantineCollection)
+}
+
+// NewValidator creates the article validator, or nil when validation is disabled.
+func NewValidator(cfg *config.Config) (*app.Validator, error) {
+	if !cfg.Validation.Enabled {
+		return nil, nil
+	}
+	return app.NewValidator(app.ValidationConfig{
+		RequiredFields:   cfg.Validation.RequiredFields,
+		MaxTitleLength:   cfg.Validation.MaxTitleLength,
+		MaxSummaryLength: cfg.Validation.MaxSummaryLength,
+		MaxBodyLength:    cfg.Validation.MaxBodyLength,
+		MaxFutureSkew:    cfg.Validation.MaxFutureSkew,
+		MaxAge:           cfg.Validation.MaxAge,
+	})
+}
+
+// NewDuplicateDetector creates the near-duplicate detector, or nil when detection is disabled.
+func NewDuplicateDetector(client *mongo.Client, cfg *config.Config) (*app.DuplicateDetector, error) {
+	if !cfg.Duplicates.Enabled {
+		return nil, nil
+	}
+	if cfg.Duplicates.Collection == "" {
+		return nil, errors.New("mongo fingerprint collection name not configured")
+	}
+	index, err := repository.NewMongoFingerprintRepository(client, cfg.MongoDBName, cfg.Duplicates.Collection)
+	if err != nil {
+		return nil, err
+	}
+	return app.NewDuplicateDetector(index, app.DuplicateConfig{
+		MaxDistance:    cfg.Duplicates.MaxDistance,
+		Window:         cfg.Duplicates.Window,
+		MinWords:       cfg.Duplicates.MinWords,
+		SuppressEvents: cfg.Duplicates.SuppressEvents,
+	})
+}
+
+// NewSourcePrecedence creates the stage keeping one primary article per story,
+// ranking sources by their configured precedence, or nil when precedence is disabled.
+func NewSourcePrecedence(store domain.PrecedenceStore, articles domain.ArticleLookup, events domain.EventProducer, cfg *config.Config) *app.SourcePrecedence {
+	if !cfg.Duplicates.Precedence {
+		return nil
+	}
+	ranks := make(map[string]int, len(cfg.Sources))
+	for _, source := range cfg.Sources {
+		ranks[source.Name] = source.Precedence
+	}
+	return app.NewSourcePrecedence(store, articles, events, ranks, suppressSecondaries(cfg))
+}
+
+// suppressSecondaries reports whether secondary articles are kept off Kafka:
+// unless near duplicates are detected with DUPLICATES_SUPPRESS_EVENTS=false.
+func suppressSecondaries(cfg *config.Config) bool {
+	return !cfg.Duplicates.Enabled || cfg.Duplicates.SuppressEvents
+}
+
+// NewStoryClusterer creates the story clusterer, or nil when clustering is disabled.
+func NewStoryClusterer(client *mongo.Client, producer *queue.KafkaProducer, cfg *config.Config) (*app.StoryClusterer, error) {
+	if !cfg.Stories.Enabled {
+		return nil, nil
+	}
+	store, err := repository.NewMongoStoryRepository(client, cfg.MongoDBName, cfg.Stories.Collection)
+	if err != nil {
+		return nil, err
+	}
+	return app.NewStoryClusterer(store, queue.NewStoryEventProducer(producer), app.StoryConfig{
+		Window:    cfg.Stories.Window,
+		Threshold: cfg.Stories.Threshold,
+	})
+}
+
+// NewURLCanonicalizer creates the default URL canonicalizer, or nil when canonicalization is disabled.
+func NewURLCanonicalizer(cfg *config.Config) *urlcanon.Canonicalizer {
+	if !cfg.URLs.Enabled {
+		return nil
+	}
+	return urlcanon.New(urlRules(cfg.URLs, nil))
+}
+
+// urlRules converts the URL config, adding a source's extra parameters to strip.
+func urlRules(cfg config.URLConfig, extraParams []string) urlcanon.Rules {
+	params := cfg.StripParams
+	if params == nil {
+		params = urlcanon.DefaultStripParams
+	}
+	return urlcanon.Rules{
+		StripParams:       append(append([]string{}, params...), extraParams...),
+		ForceHTTPS:        cfg.ForceHTTPS,
+		StripWWW:          cfg.StripWWW,
+		TrimTrailingSlash: cfg.TrimTrailingSlash,
+		ResolveAMP:        cfg.ResolveAMP,
+	}
+}
+
+// NewRevisionStore creates the MongoDB store for article revisions.
+func NewRevisionStore(client *mongo.Client, cfg *config.Config) (domain.RevisionStore, error) {
+	return repository.NewMongoRevisionRepository(client, cfg.MongoDBName, cfg.Revisions.Collection)
+}
+
+// NewRevisionRecorder creates the revision recorder, or nil when revisions are disabled.
+func NewRevisionRecorder(articles domain.ArticleLookup, store domain.RevisionStore, cfg *config.Config) *app.RevisionRecorder {
+	if !cfg.Revisions.Enabled {
+		return nil
+	}
+	return app.NewRevisionRecorder(articles, store)
+}
+
+// NewRemovalDetector creates the removed-article detector, or nil when detection is disabled.
+func NewRemovalDetector(store domain.RemovalStore, events domain.EventProducer, cfg *config.Config) (*app.RemovalDetector, error) {
+	if !cfg.Removals.Enabled {
+		return nil, nil
+	}
+	var checker app.LinkChecker
+	if cfg.Removals.VerifyURLs {
+		checker = linkcheck.New(cfg.Removals.CheckTimeout)
+	}
+	return app.NewRemovalDetector(store, checker, events, app.RemovalConfig{
+		MaxPerCrawl: cfg.Removals.MaxPerCrawl,
+	})
+}
+
+// NewVariantLinker creates the translation linker, or nil when linking is disabled.
+func NewVariantLinker(store domain.VariantStore, cfg *config.Config) (*app.VariantLinker, error) {
+	if !cfg.Language.LinkVariants {
+		return nil, nil
+	}
+	return app.NewVariantLinker(store, cfg.Language.Primary)
+}
+
+// NewMatchStore creates the MongoDB store for fixtures and results.
+func NewMatchStore(client *mongo.Client, cfg *config.Config) (domain.MatchStore, error) {
+	return repository.NewMongoMatchRepository(client, cfg.MongoDBName, cfg.Matches.Collection)
+}
+
+// NewMatchIngestion creates the stage storing and publishing crawled matches.
+func NewMatchIngestion(store domain.MatchStore, producer *queue.KafkaProducer) *app.MatchIngestion {
+	return app.NewMatchIngestion(store, queue.NewMatchEventProducer(producer))
+}
+
+// NewMatchLinker creates the article to match linker, or nil when MATCH_LINK_WINDOW is zero.
+func NewMatchLinker(store domain.MatchStore, cfg *config.Config) (*app.MatchLinker, error) {
+	if cfg.Matches.LinkWindow == 0 {
+		return nil, nil
+	}
+	return app.NewMatchLinker(store, cfg.Matches.LinkWindow)
+}
+
+// NewEmbargoScheduler creates the scheduler releasing embargoed articles; it
+// scans for due articles from start until shutdown.
+func NewEmbargoScheduler(lc fx.Lifecycle, store domain.EmbargoStore, articles domain.ArticleLookup, events domain.EventProducer, cfg *config.Config) (*app.EmbargoScheduler, error) {
+	scheduler, err := app.NewEmbargoScheduler(store, articles, events, cfg.EmbargoScan)
+	if err != nil {
+		return nil, err
+	}
+
+	runCtx, stop := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go scheduler.Run(runCtx)
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			stop()
+			return nil
+		},
+	})
+	return scheduler, nil
+}
+
+// NewReviewQueue creates the human review queue from the review rules; it
+// refreshes the queue metrics from start until shutdown.
+func NewReviewQueue(lc fx.Lifecycle, store domain.ReviewStore, articles domain.ArticleLookup, events domain.EventProducer, cfg *config.Config) (*app.ReviewQueue, error) {
+	rules := make([]app.ReviewRule, 0, len(cfg.Review.Rules))
+	for _, r := range cfg.Review.Rules {
+		matcher, err := newArticleMatcher(r.ContentRuleConfig)
+		if err != nil {
+			return nil, fmt.Errorf("review rule %s: %w", r.Name, err)
+		}
+		rules = append(rules, app.ReviewRule{Name: r.Name, Sources: r.Sources, ArticleMatcher: matcher})
+	}
+	queue, err := app.NewReviewQueue(store, articles, events, rules, cfg.Review.MetricsInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	runCtx, stop := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go queue.Run(runCtx)
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			stop()
+			return nil
+		},
+	})
+	return queue, nil
+}
+
+// NewContentHasher creates the default content hasher from HASH_FIELDS.
+func NewContentHasher(cfg *config.Config) (*app.ContentHasher, error) {
+	return newContentHasher(cfg.HashFields)
+}
+
+func newContentHasher(fields []string) (*app.ContentHasher, error) {
+	if fields == nil {
+		fields = app.DefaultHashFields
+	}
+	return app.NewContentHasher(fields)
+}
+
 // NewCMSGateway creates a CMS gateway.
 func NewCMSGateway() (domain.CMSGateway, error) {
 	return gateway.NewCMSMockGateway(), nil
 }
 
-// NewEventProducer wraps the Kafka producer as an EventProducer.
-func NewEventProducer(p *queue.KafkaProducer) (domain.EventProducer, error) {
+// NewEventProducer wraps the Kafka producer as an EventProducer that applies
+// the editorial overrides to every event and records the articles published.
+func NewEventProducer(p *queue.KafkaProducer, overrides *app.Overrides, lifecycle *app.LifecycleTracker, cfg *config.Config) (domain.EventProducer, error) {
 	if p == nil {
 		return nil, errors.New("kafka producer is nil")
 	}
-	return p, nil
+	events := app.NewLifecyclePublisher(app.NewOverridePublisher(p, overrides), lifecycle)
+	return app.NewSecondaryFilter(events, suppressSecondaries(cfg)), nil
+}
+
+// NewLifecycleStore creates the MongoDB store for article lifecycle states and their transitions.
+func NewLifecycleStore(client *mongo.Client, cfg *config.Config) (domain.LifecycleStore, error) {
+	return repository.NewMongoLifecycleRepository(client, cfg.MongoDBName, cfg.MongoColl, cfg.Lifecycle.Collection)
+}
+
+// NewLifecycleTracker creates the tracker moving articles through their lifecycle states.
+func NewLifecycleTracker(store domain.LifecycleStore) *app.LifecycleTracker {
+	return app.NewLifecycleTracker(store)
+}
+
+// NewOverrideStore creates the MongoDB store for editorial overrides and their audit trail.
+func NewOverrideStore(client *mongo.Client, cfg *config.Config) (domain.OverrideStore, error) {
+	return repository.NewMongoOverrideRepository(client, cfg.MongoDBName, cfg.Overrides.Collection, cfg.Overrides.AuditCollection)
+}
+
+// NewOverrides creates the stage applying overrides to articles leaving the service.
+func NewOverrides(store domain.OverrideStore) *app.Overrides {
+	return app.NewOverrides(store)
+}
+
+// NewOverrideService creates the service behind the override admin API.
+func NewOverrideService(store domain.OverrideStore, articles domain.ArticleLookup, events domain.EventProducer) *app.OverrideService {
+	return app.NewOverrideService(store, articles, events)
 }
 
 // NewNewsCrawlerService creates the news crawler service with validation.
 func NewNewsCrawlerService(
 	repo domain.Repository,
+	urls domain.URLReader,
 	providers []domain.Provider,
 	eventProducer domain.EventProducer,
+	validator *app.Validator,
+	quarantine domain.QuarantineWriter,
+	enrichment *app.EnrichmentChain,
+	duplicates *app.DuplicateDetector,
+	precedence *app.SourcePrecedence,
+	stories *app.StoryClusterer,
+	canonicalizer *urlcanon.Canonicalizer,
+	revisions *app.RevisionRecorder,
+	hasher *app.ContentHasher,
+	stored domain.ArticleLookup,
+	removals *app.RemovalDetector,
+	variants *app.VariantLinker,
+	matchProviders []domain.MatchProvider,
+	matchIngestion *app.MatchIngestion,
+	matches *app.MatchLinker,
+	review *app.ReviewQueue,
+	lifecycle *app.LifecycleTracker,
+	embargoes domain.EmbargoStore,
+	duplicateLinks domain.DuplicateLinks,
 	cfg *config.Config,
 ) (*app.NewsCrawlerService, error) {
 	if repo == nil {
@@ -62,6 +334,16 @@ func NewNewsCrawlerService(
 		return nil, fmt.Errorf("invalid worker pool size: %d (must be 1-100)", cfg.WorkerPoolSize)
 	}
 
+	policies, err := newSourcePolicies(cfg)
+	if err != nil {
+		return nil, err
+	}
+	for name, policy := range policies {
+		if err := enrichment.Validate(policy.Enrichers); err != nil {
+			return nil, fmt.Errorf("source %s: %w", name, err)
+		}
+	}
+
 	return app.NewNewsCrawlerService(
 		repo,
 		providers,
@@ -69,16 +351,152 @@ func NewNewsCrawlerService(
 		cfg.PollInterval,
 		cfg.BatchSize,
 		cfg.WorkerPoolSize,
+		app.WithMaxCrawlDuration(cfg.MaxCrawlDuration),
+		app.WithSourcePolicies(policies),
+		app.WithValidation(validator, quarantine),
+		app.WithEnrichment(enrichment),
+		app.WithDuplicateDetection(duplicates),
+		app.WithSourcePrecedence(precedence),
+		app.WithStoryClustering(stories),
+		app.WithURLCanonicalization(urlCanonicalizer(canonicalizer), urls),
+		app.WithRevisions(revisions),
+		app.WithContentHasher(hasher, stored),
+		app.WithRemovalDetection(removals),
+		app.WithVariantLinking(variants),
+		app.WithMatchIngestion(matchProviders, matchIngestion),
+		app.WithMatchLinking(matches),
+		app.WithReview(review),
+		app.WithLifecycle(lifecycle),
+		app.WithEmbargoes(embargoes),
+		app.WithDuplicateLinks(duplicateLinks),
 	), nil
 }
 
+// newSourcePolicies maps source configs to the per-source settings used by the crawler.
+func newSourcePolicies(cfg *config.Config) (map[string]app.SourcePolicy, error) {
+	policies := make(map[string]app.SourcePolicy, len(cfg.Sources))
+	for _, source := range cfg.Sources {
+		policy := app.SourcePolicy{
+			MaxCrawlDuration: time.Duration(source.MaxCrawlDuration),
+			Enrichers:        newEnrichmentSteps(source.Enrichers),
+		}
+		if cfg.URLs.Enabled && len(source.StripParams) > 0 {
+			policy.URLCanonicalizer = urlcanon.New(urlRules(cfg.URLs, source.StripParams))
+		}
+		if source.HashFields != nil {
+			hasher, err := newContentHasher(source.HashFields)
+			if err != nil {
+				return nil, fmt.Errorf("source %s: %w", source.Name, err)
+			}
+			policy.Hasher = hasher
+		}
+		policy.Rights = newRightsOverride(source.Rights)
+		policy.Language = strings.ToLower(source.Language)
+		if len(source.Filters) > 0 {
+			filter, err := newContentFilter(source.Filters)
+			if err != nil {
+				return nil, fmt.Errorf("source %s: %w", source.Name, err)
+			}
+			policy.Filter = filter
+		}
+		policies[source.Name] = policy
+	}
+	return policies, nil
+}
+
+// newContentFilter compiles a source's filter rules.
+func newContentFilter(rules []config.FilterRuleConfig) (*app.ContentFilter, error) {
+	compiled := make([]app.FilterRule, 0, len(rules))
+	for _, r := range rules {
+		matcher, err := newArticleMatcher(r.ContentRuleConfig)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, app.FilterRule{Name: r.Name, Action: app.FilterAction(r.Action), ArticleMatcher: matcher})
+	}
+	return app.NewContentFilter(compiled)
+}
+
+// newArticleMatcher compiles the conditions of a content rule.
+func newArticleMatcher(r config.ContentRuleConfig) (app.ArticleMatcher, error) {
+	matcher := app.ArticleMatcher{
+		Types:     r.Types,
+		Tags:      r.Tags,
+		OlderThan: time.Duration(r.OlderThan),
+	}
+	for _, p := range []struct {
+		pattern string
+		dst     **regexp.Regexp
+	}{{r.TitleRegex, &matcher.Title}, {r.BodyRegex, &matcher.Body}, {r.URLRegex, &matcher.URL}} {
+		if p.pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile(p.pattern)
+		if err != nil {
+			return app.ArticleMatcher{}, fmt.Errorf("rule %s: %w", r.Name, err)
+		}
+		*p.dst = re
+	}
+	return matcher, nil
+}
+
+// newRightsOverride converts a source's rights config, nil when it sets nothing.
+func newRightsOverride(cfg config.RightsConfig) *app.RightsOverride {
+	override := &app.RightsOverride{
+		Rights: domain.Rights{
+			CopyrightHolder: cfg.CopyrightHolder,
+			License:         cfg.License,
+			Attribution:     cfg.Attribution,
+			Restrictions:    cfg.Restrictions,
+		},
+		EmbargoDelay: time.Duration(cfg.EmbargoDelay),
+	}
+	for _, name := range cfg.Authors {
+		override.Authors = append(override.Authors, domain.Author{Name: name})
+	}
+	if len(override.Authors) == 0 && override.Rights.IsZero() && override.EmbargoDelay == 0 {
+		return nil
+	}
+	return override
+}
+
+// urlCanonicalizer avoids wrapping a nil canonicalizer in a non-nil interface.
+func urlCanonicalizer(c *urlcanon.Canonicalizer) app.URLCanonicalizer {
+	if c == nil {
+		return nil
+	}
+	return c
+}
+
 // NewCMSSyncService creates the CMS sync service.
-func NewCMSSyncService(consumer *queue.KafkaConsumer, gateway domain.CMSGateway) (*app.CMSSyncService, error) {
+func NewCMSSyncService(consumer *queue.KafkaConsumer, gateway domain.CMSGateway, lifecycle *app.LifecycleTracker) (*app.CMSSyncService, error) {
 	if consumer == nil {
 		return nil, errors.New("kafka consumer is nil")
 	}
 	if gateway == nil {
 		return nil, errors.New("CMS gateway is nil")
 	}
-	return app.NewCMSSyncService(consumer, gateway), nil
+	return app.NewCMSSyncService(consumer, gateway, lifecycle), nil
+}
+
+// NewTaxonomyService creates the taxonomy admin service; changes are applied
+// to the running taxonomy enricher.
+func NewTaxonomyService(store domain.TaxonomyStore, e *enricher.TaxonomyEnricher) *app.TaxonomyService {
+	return app.NewTaxonomyService(store, e)
+}
+
+// NewClassificationService creates the classifier dry-run service over the
+// rules the classify enricher uses.
+func NewClassificationService(e *enricher.ClassifierEnricher, articles domain.ArticleLookup) *app.ClassificationService {
+	return app.NewClassificationService(e, articles)
+}
+
+// NewArticleService creates the service behind the article API.
+func NewArticleService(revisions domain.RevisionStore, articles domain.ArticleLookup, variants domain.VariantStore, overrides *app.Overrides, lifecycle *app.LifecycleTracker) *app.ArticleService {
+	return app.NewArticleService(revisions, articles, variants, overrides, lifecycle)
+}
+
+// NewMatchService creates the service behind the match API.
+func NewMatchService(matches domain.MatchStore, articles domain.MatchArticleReader, overrides *app.Overrides) *app.MatchService {
+	return app.NewMatchService(matches, articles, overrides)
 }
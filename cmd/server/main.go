@@ -8,6 +8,7 @@ import (
 
 	"github.com/SportsNewsCrawler/cmd/server/factory"
 	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/SportsNewsCrawler/internal/domain"
 	"github.com/SportsNewsCrawler/internal/infra/tracing"
 	transport "github.com/SportsNewsCrawler/internal/transport/http"
 	"github.com/SportsNewsCrawler/pkg/config"
@@ -26,7 +27,28 @@ func main() {
 
 			// Infrastructure
 			factory.NewMongoClient,
-			factory.NewMongoRepository,
+			fx.Annotate(
+				factory.NewMongoRepository,
+				fx.As(new(domain.Repository)),
+				fx.As(new(domain.URLReader)),
+				fx.As(new(domain.ArticleLookup)),
+				fx.As(new(domain.RemovalStore)),
+				fx.As(new(domain.EmbargoStore)),
+				fx.As(new(domain.VariantStore)),
+				fx.As(new(domain.MatchArticleReader)),
+				fx.As(new(domain.ReviewStore)),
+				fx.As(new(domain.PrecedenceStore)),
+				fx.As(new(domain.DuplicateLinks)),
+			),
+			factory.NewQuarantineRepository,
+			factory.NewGazetteerSource,
+			factory.NewTaxonomyStore,
+			factory.NewRevisionStore,
+			factory.NewMatchStore,
+			factory.NewOverrideStore,
+			factory.NewLifecycleStore,
+			factory.NewLifecycleTracker,
+			factory.NewOverrides,
 			fx.Annotate(
 				factory.NewMainKafkaProducer,
 				fx.ResultTags(`name:"main_producer"`),
@@ -35,6 +57,14 @@ func main() {
 				factory.NewDLQProducer,
 				fx.ResultTags(`name:"dlq_producer"`),
 			),
+			fx.Annotate(
+				factory.NewStoryKafkaProducer,
+				fx.ResultTags(`name:"story_producer"`),
+			),
+			fx.Annotate(
+				factory.NewMatchKafkaProducer,
+				fx.ResultTags(`name:"match_producer"`),
+			),
 			fx.Annotate(
 				factory.NewKafkaConsumer,
 				fx.ParamTags(``, `name:"dlq_producer"`, ``),
@@ -44,18 +74,118 @@ func main() {
 			factory.NewCMSGateway,
 			fx.Annotate(
 				factory.NewEventProducer,
-				fx.ParamTags(`name:"main_producer"`),
+				fx.ParamTags(`name:"main_producer"`, ``, ``, ``),
 			),
 
 			// Providers
 			factory.NewProviders,
+			factory.NewMatchProviders,
+
+			// Ingestion stages
+			factory.NewValidator,
+			fx.Annotate(
+				factory.NewSanitizeEnricher,
+				fx.ResultTags(`group:"enrichers"`),
+			),
+			fx.Annotate(
+				factory.NewEntityEnricher,
+				fx.ResultTags(`group:"enrichers"`),
+			),
+			fx.Annotate(
+				factory.NewLanguageEnricher,
+				fx.ResultTags(`group:"enrichers"`),
+			),
+			fx.Annotate(
+				factory.NewResultsEnricher,
+				fx.ResultTags(`group:"enrichers"`),
+			),
+			fx.Annotate(
+				factory.NewSummaryEnricher,
+				fx.ResultTags(`group:"enrichers"`),
+			),
+			factory.NewTaxonomyEnricher,
+			fx.Annotate(
+				factory.AsTaxonomyEnricher,
+				fx.ResultTags(`group:"enrichers"`),
+			),
+			factory.NewClassifierEnricher,
+			fx.Annotate(
+				factory.AsClassifierEnricher,
+				fx.ResultTags(`group:"enrichers"`),
+			),
+			fx.Annotate(
+				factory.NewEnrichmentChain,
+				fx.ParamTags(`group:"enrichers"`),
+			),
+
+			factory.NewDuplicateDetector,
+			factory.NewSourcePrecedence,
+			factory.NewURLCanonicalizer,
+			factory.NewRevisionRecorder,
+			factory.NewContentHasher,
+			factory.NewRemovalDetector,
+			factory.NewVariantLinker,
+			factory.NewMatchLinker,
+			fx.Annotate(
+				factory.NewMatchIngestion,
+				fx.ParamTags(``, `name:"match_producer"`),
+			),
+			fx.Annotate(
+				factory.NewStoryClusterer,
+				fx.ParamTags(``, `name:"story_producer"`),
+			),
 
 			// Services
 			factory.NewNewsCrawlerService,
 			factory.NewCMSSyncService,
+			factory.NewTaxonomyService,
+			factory.NewArticleService,
+			factory.NewClassificationService,
+			factory.NewMatchService,
+			factory.NewOverrideService,
+			factory.NewEmbargoScheduler,
+			factory.NewReviewQueue,
 
 			// HTTP Server
-			transport.NewHTTPServer,
+			fx.Annotate(
+				transport.NewTaxonomyHandler,
+				fx.As(new(transport.RouteRegistrar)),
+				fx.ResultTags(`group:"routes"`),
+			),
+			fx.Annotate(
+				transport.NewArticleHandler,
+				fx.As(new(transport.RouteRegistrar)),
+				fx.ResultTags(`group:"routes"`),
+			),
+			fx.Annotate(
+				transport.NewEmbargoHandler,
+				fx.As(new(transport.RouteRegistrar)),
+				fx.ResultTags(`group:"routes"`),
+			),
+			fx.Annotate(
+				transport.NewClassificationHandler,
+				fx.As(new(transport.RouteRegistrar)),
+				fx.ResultTags(`group:"routes"`),
+			),
+			fx.Annotate(
+				transport.NewMatchHandler,
+				fx.As(new(transport.RouteRegistrar)),
+				fx.ResultTags(`group:"routes"`),
+			),
+			fx.Annotate(
+				transport.NewOverrideHandler,
+				fx.As(new(transport.RouteRegistrar)),
+				fx.ResultTags(`group:"routes"`),
+			),
+			fx.Annotate(
+				transport.NewReviewHandler,
+				fx.As(new(transport.RouteRegistrar)),
+				fx.ResultTags(`group:"routes"`),
+			),
+			fx.Annotate(
+				transport.NewHTTPServer,
+				fx.ParamTags(``, `group:"routes"`),
+			),
 		),
 		fx.Invoke(
 			SetupTracer,
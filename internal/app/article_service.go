@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// ArticleService answers queries about stored articles.
+type ArticleService struct {
+	revisions domain.RevisionStore
+	articles  domain.ArticleLookup
+	variants  domain.VariantStore
+	overrides *Overrides
+	lifecycle *LifecycleTracker
+}
+
+func NewArticleService(revisions domain.RevisionStore, articles domain.ArticleLookup, variants domain.VariantStore, overrides *Overrides, lifecycle *LifecycleTracker) *ArticleService {
+	return &ArticleService{revisions: revisions, articles: articles, variants: variants, overrides: overrides, lifecycle: lifecycle}
+}
+
+// GetArticle returns a stored article with its overrides applied.
+func (s *ArticleService) GetArticle(ctx context.Context, articleID string) (*domain.Article, error) {
+	found, err := s.articles.GetArticles(ctx, []string{articleID})
+	if err != nil {
+		return nil, err
+	}
+	article, ok := found[articleID]
+	if !ok {
+		return nil, fmt.Errorf("article %s: %w", articleID, domain.ErrNotFound)
+	}
+	articles := []domain.Article{article}
+	if err := s.overrides.Apply(ctx, articles); err != nil {
+		return nil, err
+	}
+	return &articles[0], nil
+}
+
+// ListByState returns the articles in a lifecycle state with their overrides
+// applied, most recently changed first.
+func (s *ArticleService) ListByState(ctx context.Context, state domain.LifecycleState, limit int) ([]domain.Article, error) {
+	articles, err := s.lifecycle.ListByState(ctx, state, limit)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.overrides.Apply(ctx, articles); err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+// ListTransitions returns an article's lifecycle transitions, newest first.
+func (s *ArticleService) ListTransitions(ctx context.Context, articleID string, limit int) ([]domain.LifecycleTransition, error) {
+	return s.lifecycle.ListTransitions(ctx, articleID, limit)
+}
+
+// ListRevisions returns the prior versions of an article, newest first.
+func (s *ArticleService) ListRevisions(ctx context.Context, articleID string, limit int) ([]domain.ArticleRevision, error) {
+	return s.revisions.ListRevisions(ctx, articleID, limit)
+}
+
+// ListVariants returns the language versions of an article, itself included,
+// ordered by language.
+func (s *ArticleService) ListVariants(ctx context.Context, articleID string) ([]domain.Variant, error) {
+	found, err := s.articles.GetArticles(ctx, []string{articleID})
+	if err != nil {
+		return nil, err
+	}
+	article, ok := found[articleID]
+	if !ok {
+		return nil, fmt.Errorf("article %s: %w", articleID, domain.ErrNotFound)
+	}
+	if article.VariantGroup == "" {
+		return []domain.Variant{variantOf(&article)}, nil
+	}
+
+	groups, err := s.variants.FindVariants(ctx, []string{article.VariantGroup})
+	if err != nil {
+		return nil, err
+	}
+	variants := groups[article.VariantGroup]
+	sortVariants(variants)
+	return variants, nil
+}
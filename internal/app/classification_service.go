@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// ArticleClassifier is the ingestion side of classification: the rules in use.
+type ArticleClassifier interface {
+	Classify(article *domain.Article) domain.Classification
+}
+
+// ClassificationService shows how the classification rules in use label
+// articles, without changing them.
+type ClassificationService struct {
+	classifier ArticleClassifier
+	articles   domain.ArticleLookup
+}
+
+func NewClassificationService(classifier ArticleClassifier, articles domain.ArticleLookup) *ClassificationService {
+	return &ClassificationService{classifier: classifier, articles: articles}
+}
+
+// DryRun classifies the given article and reports which rules fired.
+func (s *ClassificationService) DryRun(article *domain.Article) domain.Classification {
+	return s.classifier.Classify(article)
+}
+
+// DryRunStored classifies a stored article with the current rules.
+func (s *ClassificationService) DryRunStored(ctx context.Context, id string) (domain.Classification, error) {
+	found, err := s.articles.GetArticles(ctx, []string{id})
+	if err != nil {
+		return domain.Classification{}, err
+	}
+	article, ok := found[id]
+	if !ok {
+		return domain.Classification{}, fmt.Errorf("article %s: %w", id, domain.ErrNotFound)
+	}
+	return s.classifier.Classify(&article), nil
+}
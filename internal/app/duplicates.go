@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+const (
+	simHashBands         = 4 // 16-bit bands: fingerprints within 3 bits share at least one
+	maxDuplicateDistance = simHashBands - 1
+	shingleSize          = 3 // Words per shingle
+)
+
+// DuplicateConfig configures near-duplicate detection.
+type DuplicateConfig struct {
+	MaxDistance    int           // Max Hamming distance between SimHashes, at most 3
+	Window         time.Duration // Only articles published this close to each other are compared
+	MinWords       int           // Shorter texts are not fingerprinted, they collide too easily
+	SuppressEvents bool          // Do not publish Kafka events for near duplicates
+}
+
+// DuplicateDetector links articles to an earlier article with nearly the same
+// title and body, e.g. one wire story republished by several outlets.
+type DuplicateDetector struct {
+	index domain.FingerprintIndex
+	cfg   DuplicateConfig
+	now   func() time.Time
+}
+
+func NewDuplicateDetector(index domain.FingerprintIndex, cfg DuplicateConfig) (*DuplicateDetector, error) {
+	if cfg.MaxDistance < 0 || cfg.MaxDistance > maxDuplicateDistance {
+		return nil, fmt.Errorf("max distance must be between 0 and %d, got %d", maxDuplicateDistance, cfg.MaxDistance)
+	}
+	return &DuplicateDetector{index: index, cfg: cfg, now: time.Now}, nil
+}
+
+// SuppressEvents reports whether near duplicates should be kept off the event stream.
+func (d *DuplicateDetector) SuppressEvents() bool {
+	return d.cfg.SuppressEvents
+}
+
+// Detect sets DuplicateOf on each article that near-duplicates an indexed (or
+// earlier in the batch) article and indexes the articles' fingerprints.
+func (d *DuplicateDetector) Detect(ctx context.Context, articles []*domain.Article) error {
+	now := d.now()
+	var fingerprints []domain.Fingerprint
+	var bands []string
+	from, to := time.Time{}, time.Time{}
+	for _, a := range articles {
+		fp, ok := d.fingerprint(a, now)
+		if !ok {
+			continue
+		}
+		fingerprints = append(fingerprints, fp)
+		bands = append(bands, fp.Bands...)
+		if from.IsZero() || fp.PublishedAt.Before(from) {
+			from = fp.PublishedAt
+		}
+		if fp.PublishedAt.After(to) {
+			to = fp.PublishedAt
+		}
+	}
+	if len(fingerprints) == 0 {
+		return nil
+	}
+
+	candidates, err := d.index.FindCandidates(ctx, bands, from.Add(-d.cfg.Window), to.Add(d.cfg.Window))
+	if err != nil {
+		return fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+
+	byID := make(map[string]*domain.Article, len(articles))
+	for _, a := range articles {
+		byID[a.ID] = a
+	}
+	for i := range fingerprints {
+		fp := &fingerprints[i]
+		// Earlier articles of the batch are candidates too
+		pool := append(candidates[:len(candidates):len(candidates)], fingerprints[:i]...)
+		if match, ok := d.closest(fp, pool); ok {
+			fp.CanonicalID = match.CanonicalID
+			byID[fp.ArticleID].DuplicateOf = match.CanonicalID
+		}
+	}
+
+	return d.index.SaveFingerprints(ctx, fingerprints)
+}
+
+func (d *DuplicateDetector) fingerprint(a *domain.Article, now time.Time) (domain.Fingerprint, bool) {
+	body := a.BodyText
+	if body == "" {
+		body = textproc.ToText(a.Body)
+	}
+	words := textproc.LowerWords(a.Title + "\n" + body)
+	if len(words) < d.cfg.MinWords || len(words) == 0 {
+		return domain.Fingerprint{}, false
+	}
+
+	published := a.PublishedAt
+	if published.IsZero() {
+		published = now
+	}
+	hash := textproc.SimHash(words, shingleSize)
+	return domain.Fingerprint{
+		ArticleID:   a.ID,
+		Source:      a.Source,
+		SimHash:     hash,
+		Bands:       simHashBandKeys(hash),
+		CanonicalID: a.ID,
+		PublishedAt: published,
+		CreatedAt:   now,
+	}, true
+}
+
+// closest returns the candidate nearest to fp within MaxDistance and Window,
+// preferring the earliest published on ties.
+func (d *DuplicateDetector) closest(fp *domain.Fingerprint, candidates []domain.Fingerprint) (domain.Fingerprint, bool) {
+	var best domain.Fingerprint
+	bestDistance := d.cfg.MaxDistance + 1
+	for _, c := range candidates {
+		// Never link an article to itself or to articles already linked to it
+		if c.ArticleID == fp.ArticleID || c.CanonicalID == fp.ArticleID {
+			continue
+		}
+		if gap := fp.PublishedAt.Sub(c.PublishedAt); gap > d.cfg.Window || -gap > d.cfg.Window {
+			continue
+		}
+		distance := textproc.HammingDistance(fp.SimHash, c.SimHash)
+		if distance < bestDistance || (distance == bestDistance && c.PublishedAt.Before(best.PublishedAt)) {
+			best, bestDistance = c, distance
+		}
+	}
+	return best, bestDistance <= d.cfg.MaxDistance
+}
+
+// simHashBandKeys splits a SimHash into band keys such as "2:9f3a".
+func simHashBandKeys(hash uint64) []string {
+	keys := make([]string, simHashBands)
+	width := 64 / simHashBands
+	for i := range keys {
+		band := (hash >> (i * width)) & (1<<width - 1)
+		keys[i] = fmt.Sprintf("%d:%04x", i, band)
+	}
+	return keys
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryFingerprintIndex struct {
+	saved map[string]domain.Fingerprint
+}
+
+func (m *memoryFingerprintIndex) FindCandidates(_ context.Context, bands []string, from, to time.Time) ([]domain.Fingerprint, error) {
+	var out []domain.Fingerprint
+	for _, fp := range m.saved {
+		if fp.PublishedAt.Before(from) || fp.PublishedAt.After(to) {
+			continue
+		}
+		for _, b := range fp.Bands {
+			if hasBand(bands, b) {
+				out = append(out, fp)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryFingerprintIndex) SaveFingerprints(_ context.Context, fps []domain.Fingerprint) error {
+	for _, fp := range fps {
+		m.saved[fp.ArticleID] = fp
+	}
+	return nil
+}
+
+func hasBand(bands []string, band string) bool {
+	for _, b := range bands {
+		if b == band {
+			return true
+		}
+	}
+	return false
+}
+
+const wireBody = "<p>England won the first Test against Australia at Lord's by 45 runs on Sunday, with Joe Root " +
+	"scoring an unbeaten century and Mark Wood taking five wickets in the final session of a tense day.</p>"
+
+func TestDuplicateDetector_Detect(t *testing.T) {
+	ctx := context.Background()
+	published := time.Date(2026, 7, 1, 18, 0, 0, 0, time.UTC)
+	index := &memoryFingerprintIndex{saved: map[string]domain.Fingerprint{}}
+	detector, err := NewDuplicateDetector(index, DuplicateConfig{MaxDistance: 3, Window: 48 * time.Hour, MinWords: 20})
+	require.NoError(t, err)
+
+	original := &domain.Article{ID: "a", Source: "wire", Title: "England win at Lord's", Body: wireBody, PublishedAt: published}
+	require.NoError(t, detector.Detect(ctx, []*domain.Article{original}))
+	assert.Empty(t, original.DuplicateOf)
+
+	copy1 := &domain.Article{ID: "b", Source: "outlet", Title: "England win at Lord's", Body: wireBody, PublishedAt: published.Add(time.Hour)}
+	copy2 := &domain.Article{ID: "c", Source: "other", Title: "England win at Lord's", Body: wireBody, PublishedAt: published.Add(2 * time.Hour)}
+	late := &domain.Article{ID: "d", Source: "late", Title: "England win at Lord's", Body: wireBody, PublishedAt: published.Add(72 * time.Hour)}
+	short := &domain.Article{ID: "e", Source: "outlet", Title: "England win", Body: "<p>By 45 runs.</p>", PublishedAt: published}
+	require.NoError(t, detector.Detect(ctx, []*domain.Article{copy1, copy2, late, short}))
+
+	assert.Equal(t, "a", copy1.DuplicateOf)
+	assert.Equal(t, "a", copy2.DuplicateOf, "duplicates link to the canonical article, not to each other")
+	assert.Empty(t, late.DuplicateOf, "outside the time window")
+	assert.Empty(t, short.DuplicateOf)
+	assert.NotContains(t, index.saved, "e", "texts under MinWords are not indexed")
+
+	// Re-detecting the canonical article after an edit must not link it to its own duplicates
+	require.NoError(t, detector.Detect(ctx, []*domain.Article{original}))
+	assert.Empty(t, original.DuplicateOf)
+}
+
+func TestNewDuplicateDetector_MaxDistance(t *testing.T) {
+	_, err := NewDuplicateDetector(&memoryFingerprintIndex{}, DuplicateConfig{MaxDistance: 4})
+	assert.Error(t, err)
+}
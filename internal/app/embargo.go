@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+)
+
+// embargoReleaseBatch is the number of due articles released per query.
+const embargoReleaseBatch = 200
+
+// EmbargoScheduler publishes the events of embargoed articles once their
+// embargo ends. The crawler persists them with EmbargoHeld set instead of
+// publishing them.
+type EmbargoScheduler struct {
+	store    domain.EmbargoStore
+	articles domain.ArticleLookup
+	events   domain.EventProducer
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewEmbargoScheduler(store domain.EmbargoStore, articles domain.ArticleLookup, events domain.EventProducer, interval time.Duration) (*EmbargoScheduler, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("embargo scan interval must be positive, got %s", interval)
+	}
+	return &EmbargoScheduler{store: store, articles: articles, events: events, interval: interval, now: time.Now}, nil
+}
+
+// Run releases due articles every interval until ctx is cancelled.
+func (s *EmbargoScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ReleaseDue(ctx); err != nil {
+			slog.Error("Failed to release embargoed articles", "error", err)
+		}
+		if held, err := s.store.CountHeld(ctx); err == nil {
+			metrics.EmbargoPending.Set(float64(held))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReleaseDue publishes the events of the articles whose embargo has ended and
+// returns how many were released.
+func (s *EmbargoScheduler) ReleaseDue(ctx context.Context) (int, error) {
+	released := 0
+	for {
+		due, err := s.store.FindHeld(ctx, s.now(), embargoReleaseBatch)
+		if err != nil {
+			return released, fmt.Errorf("failed to find due articles: %w", err)
+		}
+		if len(due) == 0 {
+			return released, nil
+		}
+		if err := s.release(ctx, due, "scheduled"); err != nil {
+			return released, err
+		}
+		released += len(due)
+		if len(due) < embargoReleaseBatch {
+			return released, nil
+		}
+	}
+}
+
+// ListHeld returns the articles waiting for their embargo to end, earliest first.
+func (s *EmbargoScheduler) ListHeld(ctx context.Context, limit int) ([]domain.Article, error) {
+	return s.store.FindHeld(ctx, time.Time{}, limit)
+}
+
+// Release publishes a held article's event before its embargo ends.
+func (s *EmbargoScheduler) Release(ctx context.Context, id string) (*domain.Article, error) {
+	found, err := s.articles.GetArticles(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	article, ok := found[id]
+	if !ok {
+		return nil, fmt.Errorf("article %s: %w", id, domain.ErrNotFound)
+	}
+	if !article.EmbargoHeld {
+		return nil, fmt.Errorf("article %s is not held by an embargo: %w", id, domain.ErrInvalidInput)
+	}
+	if article.Review.Withholds() {
+		return nil, fmt.Errorf("article %s is %s: %w", id, article.Review.Status, domain.ErrInvalidInput)
+	}
+	if err := s.release(ctx, []domain.Article{article}, "manual"); err != nil {
+		return nil, err
+	}
+	slog.Info("Embargoed article released early", "id", id, "embargo_until", article.EmbargoUntil)
+	article.EmbargoHeld = false
+	return &article, nil
+}
+
+// release publishes the articles' events, then clears their held flag. A
+// failure between the two publishes them again on the next scan.
+func (s *EmbargoScheduler) release(ctx context.Context, articles []domain.Article, trigger string) error {
+	ids := make([]string, 0, len(articles))
+	for i := range articles {
+		articles[i].EmbargoHeld = false
+		ids = append(ids, articles[i].ID)
+	}
+	if err := s.events.PublishBatch(ctx, articles); err != nil {
+		return fmt.Errorf("failed to publish embargoed articles: %w", err)
+	}
+	if err := s.store.MarkReleased(ctx, ids); err != nil {
+		return fmt.Errorf("failed to mark embargoed articles released: %w", err)
+	}
+	metrics.EmbargoReleases.WithLabelValues(trigger).Add(float64(len(articles)))
+	return nil
+}
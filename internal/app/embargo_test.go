@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryEmbargoes map[string]*domain.Article
+
+func (m memoryEmbargoes) FindHeld(_ context.Context, due time.Time, limit int) ([]domain.Article, error) {
+	var out []domain.Article
+	for _, a := range m {
+		if a.EmbargoHeld && (due.IsZero() || a.EmbargoUntil == nil || !a.EmbargoUntil.After(due)) {
+			out = append(out, *a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (m memoryEmbargoes) CountHeld(ctx context.Context) (int64, error) {
+	held, _ := m.FindHeld(ctx, time.Time{}, 0)
+	return int64(len(held)), nil
+}
+
+func (m memoryEmbargoes) MarkReleased(_ context.Context, ids []string) error {
+	for _, id := range ids {
+		m[id].EmbargoHeld = false
+	}
+	return nil
+}
+
+func (m memoryEmbargoes) GetArticles(_ context.Context, ids []string) (map[string]domain.Article, error) {
+	out := map[string]domain.Article{}
+	for _, id := range ids {
+		if a, ok := m[id]; ok {
+			out[id] = *a
+		}
+	}
+	return out, nil
+}
+
+func TestEmbargoScheduler(t *testing.T) {
+	now := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+	store := memoryEmbargoes{
+		"due":      {ID: "due", EmbargoUntil: &past, EmbargoHeld: true},
+		"lifted":   {ID: "lifted", EmbargoHeld: true},
+		"pending":  {ID: "pending", EmbargoUntil: &future, EmbargoHeld: true},
+		"released": {ID: "released", EmbargoUntil: &past},
+	}
+	events := &recordingProducer{}
+	scheduler, err := NewEmbargoScheduler(store, store, events, time.Minute)
+	require.NoError(t, err)
+	scheduler.now = func() time.Time { return now }
+
+	released, err := scheduler.ReleaseDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, released)
+	require.Len(t, events.published, 2)
+	assert.Equal(t, "due", events.published[0].ID)
+	assert.Equal(t, "lifted", events.published[1].ID)
+
+	held, err := scheduler.ListHeld(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+	assert.Equal(t, "pending", held[0].ID)
+
+	article, err := scheduler.Release(context.Background(), "pending")
+	require.NoError(t, err)
+	assert.False(t, article.EmbargoHeld)
+	assert.Len(t, events.published, 3)
+
+	_, err = scheduler.Release(context.Background(), "pending")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = scheduler.Release(context.Background(), "unknown")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
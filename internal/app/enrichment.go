@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// FailurePolicy decides what happens to an article when an enricher fails on it.
+type FailurePolicy string
+
+const (
+	FailureSkip FailurePolicy = "skip" // Keep the article without this enricher's data
+	FailureDrop FailurePolicy = "drop" // Remove the article from the batch
+	FailureFail FailurePolicy = "fail" // Fail the whole batch
+)
+
+// EnrichmentStep is one entry of a source's enrichment chain.
+type EnrichmentStep struct {
+	Name    string
+	OnError FailurePolicy
+}
+
+// EnrichmentChain runs an ordered list of enrichers over each batch.
+// Sources pick their own steps; those without a chain use the defaults.
+type EnrichmentChain struct {
+	enrichers map[string]domain.Enricher
+	defaults  []EnrichmentStep
+}
+
+// NewEnrichmentChain registers the available enrichers and the default chain.
+func NewEnrichmentChain(enrichers []domain.Enricher, defaults []EnrichmentStep) (*EnrichmentChain, error) {
+	c := &EnrichmentChain{
+		enrichers: make(map[string]domain.Enricher, len(enrichers)),
+	}
+	for _, e := range enrichers {
+		if _, exists := c.enrichers[e.Name()]; exists {
+			return nil, fmt.Errorf("duplicate enricher: %s", e.Name())
+		}
+		c.enrichers[e.Name()] = e
+	}
+	if err := c.Validate(defaults); err != nil {
+		return nil, fmt.Errorf("invalid default enrichment chain: %w", err)
+	}
+	c.defaults = defaults
+	return c, nil
+}
+
+// Validate checks that every step names a registered enricher and a known policy.
+func (c *EnrichmentChain) Validate(steps []EnrichmentStep) error {
+	for _, step := range steps {
+		if _, ok := c.enrichers[step.Name]; !ok {
+			return fmt.Errorf("enricher not found: %s", step.Name)
+		}
+		switch step.OnError {
+		case "", FailureSkip, FailureDrop, FailureFail:
+		default:
+			return fmt.Errorf("enricher %s: unknown failure policy %q", step.Name, step.OnError)
+		}
+	}
+	return nil
+}
+
+// Run applies steps (or the defaults when steps is nil) to the articles in order
+// and returns the articles that survived the chain.
+func (c *EnrichmentChain) Run(ctx context.Context, provider string, steps []EnrichmentStep, articles []domain.Article) ([]domain.Article, error) {
+	if steps == nil {
+		steps = c.defaults
+	}
+
+	tr := otel.Tracer("news-crawler")
+	for _, step := range steps {
+		enricher := c.enrichers[step.Name]
+		policy := step.OnError
+		if policy == "" {
+			policy = FailureSkip
+		}
+
+		stepCtx, span := tr.Start(ctx, "enrich."+step.Name)
+		span.SetAttributes(
+			attribute.String("provider", provider),
+			attribute.String("enricher", step.Name),
+			attribute.Int("batch_size", len(articles)),
+		)
+
+		kept := articles[:0]
+		failures := 0
+		for i := range articles {
+			original := articles[i].Clone()
+			start := time.Now()
+			err := enricher.Enrich(stepCtx, &articles[i])
+			metrics.EnricherDuration.WithLabelValues(step.Name).Observe(time.Since(start).Seconds())
+
+			if err == nil {
+				kept = append(kept, articles[i])
+				continue
+			}
+
+			failures++
+			articles[i] = original
+			metrics.EnricherErrors.WithLabelValues(provider, step.Name, string(policy)).Inc()
+
+			switch policy {
+			case FailureFail:
+				span.RecordError(err)
+				span.End()
+				return nil, fmt.Errorf("enricher %s failed on article %s: %w", step.Name, original.ID, err)
+			case FailureDrop:
+				slog.Warn("Enricher failed, dropping article", "provider", provider, "enricher", step.Name, "id", original.ID, "error", err)
+			default:
+				slog.Warn("Enricher failed, skipping", "provider", provider, "enricher", step.Name, "id", original.ID, "error", err)
+				kept = append(kept, articles[i])
+			}
+		}
+
+		span.SetAttributes(attribute.Int("failures", failures))
+		span.End()
+		articles = kept
+	}
+
+	return articles, nil
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// funcEnricher adapts a function to domain.Enricher.
+type funcEnricher struct {
+	name string
+	fn   func(a *domain.Article) error
+}
+
+func (e *funcEnricher) Name() string { return e.name }
+
+func (e *funcEnricher) Enrich(_ context.Context, a *domain.Article) error { return e.fn(a) }
+
+func newTestChain(t *testing.T) *EnrichmentChain {
+	suffix := &funcEnricher{name: "suffix", fn: func(a *domain.Article) error {
+		a.Summary += "+suffix"
+		return nil
+	}}
+	flaky := &funcEnricher{name: "flaky", fn: func(a *domain.Article) error {
+		if a.ID == "bad" {
+			a.Summary = "partially written"
+			return errors.New("boom")
+		}
+		a.Description = "flaky ran"
+		return nil
+	}}
+	chain, err := NewEnrichmentChain([]domain.Enricher{suffix, flaky}, []EnrichmentStep{{Name: "suffix"}})
+	require.NoError(t, err)
+	return chain
+}
+
+func TestEnrichmentChain_Policies(t *testing.T) {
+	batch := func() []domain.Article {
+		return []domain.Article{{ID: "good"}, {ID: "bad"}}
+	}
+
+	t.Run("defaults when source has no chain", func(t *testing.T) {
+		out, err := newTestChain(t).Run(context.Background(), "p", nil, batch())
+		require.NoError(t, err)
+		assert.Len(t, out, 2)
+		assert.Equal(t, "+suffix", out[1].Summary)
+	})
+
+	t.Run("empty chain disables enrichment", func(t *testing.T) {
+		out, err := newTestChain(t).Run(context.Background(), "p", []EnrichmentStep{}, batch())
+		require.NoError(t, err)
+		assert.Equal(t, "", out[0].Summary)
+	})
+
+	t.Run("skip keeps article and restores it", func(t *testing.T) {
+		out, err := newTestChain(t).Run(context.Background(), "p",
+			[]EnrichmentStep{{Name: "flaky", OnError: FailureSkip}, {Name: "suffix"}}, batch())
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+		assert.Equal(t, "bad", out[1].ID)
+		assert.Equal(t, "+suffix", out[1].Summary, "partial writes of the failed enricher are discarded")
+		assert.Equal(t, "flaky ran", out[0].Description)
+	})
+
+	t.Run("skip restores slices changed in place", func(t *testing.T) {
+		inPlace := &funcEnricher{name: "in-place", fn: func(a *domain.Article) error {
+			a.Tags[0].Label = "rewritten"
+			a.Media[0].Thumbnails[0].URL = "rewritten"
+			a.Keywords = append(a.Keywords[:0], "rewritten")
+			return errors.New("boom")
+		}}
+		chain, err := NewEnrichmentChain([]domain.Enricher{inPlace}, nil)
+		require.NoError(t, err)
+		article := domain.Article{
+			ID:       "a",
+			Tags:     []domain.Tag{{ID: 1, Label: "Cricket"}},
+			Media:    []domain.Media{{Thumbnails: []domain.Thumbnail{{URL: "thumb"}}}},
+			Keywords: []string{"ashes"},
+		}
+
+		out, err := chain.Run(context.Background(), "p", []EnrichmentStep{{Name: "in-place"}}, []domain.Article{article})
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "Cricket", out[0].Tags[0].Label)
+		assert.Equal(t, "thumb", out[0].Media[0].Thumbnails[0].URL)
+		assert.Equal(t, []string{"ashes"}, out[0].Keywords)
+	})
+
+	t.Run("drop removes article", func(t *testing.T) {
+		out, err := newTestChain(t).Run(context.Background(), "p",
+			[]EnrichmentStep{{Name: "flaky", OnError: FailureDrop}}, batch())
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, "good", out[0].ID)
+	})
+
+	t.Run("fail aborts batch", func(t *testing.T) {
+		_, err := newTestChain(t).Run(context.Background(), "p",
+			[]EnrichmentStep{{Name: "flaky", OnError: FailureFail}}, batch())
+		assert.ErrorContains(t, err, "enricher flaky failed on article bad")
+	})
+}
+
+func TestEnrichmentChain_Validate(t *testing.T) {
+	chain := newTestChain(t)
+	assert.NoError(t, chain.Validate([]EnrichmentStep{{Name: "flaky", OnError: FailureDrop}}))
+	assert.Error(t, chain.Validate([]EnrichmentStep{{Name: "missing"}}))
+	assert.Error(t, chain.Validate([]EnrichmentStep{{Name: "flaky", OnError: "retry"}}))
+
+	_, err := NewEnrichmentChain(nil, []EnrichmentStep{{Name: "missing"}})
+	assert.Error(t, err)
+}
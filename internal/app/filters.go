@@ -0,0 +1,121 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// FilterAction is what a filter rule does with the articles it matches.
+type FilterAction string
+
+const (
+	FilterAllow FilterAction = "allow" // Drop the articles the rule does not match
+	FilterBlock FilterAction = "block" // Drop the articles the rule matches
+)
+
+// ArticleMatcher matches articles on their content. It matches an article
+// when all the conditions it sets match.
+type ArticleMatcher struct {
+	Types     []string       // Any of these content types
+	Tags      []string       // Any tag with this label, ignoring case, or ID
+	Title     *regexp.Regexp // Matched against the title
+	Body      *regexp.Regexp // Matched against the body
+	URL       *regexp.Regexp // Matched against the URL
+	OlderThan time.Duration  // Published longer ago than this
+}
+
+// Matches reports whether the article matches every condition set.
+func (m *ArticleMatcher) Matches(a *domain.Article, now time.Time) bool {
+	if len(m.Types) > 0 && !containsFold(m.Types, a.Type) {
+		return false
+	}
+	if len(m.Tags) > 0 && !hasTag(a, m.Tags) {
+		return false
+	}
+	if m.Title != nil && !m.Title.MatchString(a.Title) {
+		return false
+	}
+	if m.Body != nil && !m.Body.MatchString(a.Body) {
+		return false
+	}
+	if m.URL != nil && !m.URL.MatchString(a.URL) {
+		return false
+	}
+	if m.OlderThan > 0 && (a.PublishedAt.IsZero() || !a.PublishedAt.Before(now.Add(-m.OlderThan))) {
+		return false
+	}
+	return true
+}
+
+// IsZero reports whether no condition is set; such a matcher matches everything.
+func (m *ArticleMatcher) IsZero() bool {
+	return len(m.Types) == 0 && len(m.Tags) == 0 && m.Title == nil && m.Body == nil && m.URL == nil && m.OlderThan == 0
+}
+
+// FilterRule is one of a source's content filter rules.
+type FilterRule struct {
+	Name   string
+	Action FilterAction
+	ArticleMatcher
+}
+
+func hasTag(a *domain.Article, tags []string) bool {
+	for _, t := range a.Tags {
+		if containsFold(tags, t.Label) || contains(tags, strconv.Itoa(t.ID)) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContentFilter drops a source's unwanted articles before they are persisted.
+type ContentFilter struct {
+	rules []FilterRule
+	now   func() time.Time
+}
+
+func NewContentFilter(rules []FilterRule) (*ContentFilter, error) {
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("filter rule name is required")
+		}
+		if seen[r.Name] {
+			return nil, fmt.Errorf("duplicate filter rule %q", r.Name)
+		}
+		seen[r.Name] = true
+		if r.Action != FilterAllow && r.Action != FilterBlock {
+			return nil, fmt.Errorf("filter rule %s: action must be allow or block", r.Name)
+		}
+		if r.IsZero() {
+			return nil, fmt.Errorf("filter rule %s: no condition set", r.Name)
+		}
+	}
+	return &ContentFilter{rules: rules, now: time.Now}, nil
+}
+
+// Check returns the name of the first rule dropping the article, or "" to keep it.
+func (f *ContentFilter) Check(a *domain.Article) string {
+	now := f.now()
+	for i := range f.rules {
+		r := &f.rules[i]
+		if r.Matches(a, now) == (r.Action == FilterBlock) {
+			return r.Name
+		}
+	}
+	return ""
+}
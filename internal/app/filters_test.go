@@ -0,0 +1,45 @@
+package app
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentFilter(t *testing.T) {
+	now := time.Date(2026, 7, 10, 12, 0, 0, 0, time.UTC)
+	filter, err := NewContentFilter([]FilterRule{
+		{Name: "text-and-video", Action: FilterAllow, ArticleMatcher: ArticleMatcher{Types: []string{"text", "video"}}},
+		{Name: "sponsored", Action: FilterBlock, ArticleMatcher: ArticleMatcher{Tags: []string{"sponsored", "99"}}},
+		{Name: "betting", Action: FilterBlock, ArticleMatcher: ArticleMatcher{Title: regexp.MustCompile(`(?i)\b(odds|betting)\b`)}},
+		{Name: "archive", Action: FilterBlock, ArticleMatcher: ArticleMatcher{URL: regexp.MustCompile(`/archive/`), OlderThan: 30 * 24 * time.Hour}},
+	})
+	require.NoError(t, err)
+	filter.now = func() time.Time { return now }
+
+	tests := []struct {
+		name    string
+		article domain.Article
+		want    string
+	}{
+		{"kept", domain.Article{Type: "text", Title: "Root hits century", PublishedAt: now}, ""},
+		{"type not allowed", domain.Article{Type: "photo", Title: "Gallery"}, "text-and-video"},
+		{"tag label", domain.Article{Type: "text", Tags: []domain.Tag{{ID: 1, Label: "Sponsored"}}}, "sponsored"},
+		{"tag id", domain.Article{Type: "video", Tags: []domain.Tag{{ID: 99, Label: "Partner"}}}, "sponsored"},
+		{"title", domain.Article{Type: "text", Title: "Latest Ashes odds"}, "betting"},
+		{"old archive page", domain.Article{Type: "text", URL: "https://ecb.co.uk/archive/1", PublishedAt: now.AddDate(0, -2, 0)}, "archive"},
+		{"recent archive page", domain.Article{Type: "text", URL: "https://ecb.co.uk/archive/2", PublishedAt: now.AddDate(0, 0, -1)}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filter.Check(&tt.article))
+		})
+	}
+
+	_, err = NewContentFilter([]FilterRule{{Name: "empty", Action: FilterBlock}})
+	assert.Error(t, err, "a rule without conditions would drop everything")
+}
@@ -0,0 +1,161 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+// hashNormalization is bumped whenever field normalization changes, so stored
+// hashes are recognised as computed differently.
+const hashNormalization = "n1"
+
+// DefaultHashFields are hashed for sources without their own field list.
+var DefaultHashFields = []string{"source", "url", "title", "description", "summary", "body", "image_url", "tags"}
+
+// hashFieldValues return the normalized value of each hashable field.
+var hashFieldValues = map[string]func(*domain.Article) string{
+	"source":      func(a *domain.Article) string { return a.Source },
+	"type":        func(a *domain.Article) string { return a.Type },
+	"url":         func(a *domain.Article) string { return a.URL },
+	"title":       func(a *domain.Article) string { return textproc.Normalize(a.Title) },
+	"description": func(a *domain.Article) string { return textproc.Normalize(a.Description) },
+	"summary":     func(a *domain.Article) string { return textproc.Normalize(a.Summary) },
+	"body":        func(a *domain.Article) string { return textproc.Normalize(a.Body) },
+	"body_text":   func(a *domain.Article) string { return textproc.Normalize(a.BodyText) },
+	"image_url":   func(a *domain.Article) string { return withoutQuery(a.ImageURL) },
+	"published_at": func(a *domain.Article) string {
+		if a.PublishedAt.IsZero() {
+			return ""
+		}
+		return a.PublishedAt.UTC().Format(time.RFC3339)
+	},
+	"tags": func(a *domain.Article) string {
+		tags := make([]string, 0, len(a.Tags))
+		for _, t := range a.Tags {
+			tags = append(tags, strconv.Itoa(t.ID)+"="+textproc.Normalize(t.Label))
+		}
+		sort.Strings(tags)
+		return strings.Join(tags, "\n")
+	},
+	"media": func(a *domain.Article) string {
+		urls := make([]string, 0, len(a.Media))
+		for _, m := range a.Media {
+			urls = append(urls, mediaURLs(m)...)
+		}
+		return strings.Join(urls, "\n")
+	},
+	"authors": func(a *domain.Article) string {
+		names := make([]string, 0, len(a.Authors))
+		for _, au := range a.Authors {
+			names = append(names, textproc.Normalize(au.Name))
+		}
+		return strings.Join(names, "\n")
+	},
+	"rights": func(a *domain.Article) string {
+		r := a.Rights
+		return strings.Join([]string{r.CopyrightHolder, r.License, r.Attribution, strings.Join(r.Restrictions, ",")}, "\n")
+	},
+	"embargo_until": func(a *domain.Article) string {
+		if a.EmbargoUntil == nil {
+			return ""
+		}
+		return a.EmbargoUntil.UTC().Format(time.RFC3339)
+	},
+	"canonical_tags": func(a *domain.Article) string {
+		ids := make([]string, 0, len(a.CanonicalTags))
+		for _, t := range a.CanonicalTags {
+			ids = append(ids, t.ID)
+		}
+		sort.Strings(ids)
+		return strings.Join(ids, "\n")
+	},
+}
+
+// ContentHasher hashes a configurable set of normalized article fields. Its
+// hashes are prefixed with a version identifying the field set and
+// normalization, e.g. "h1-5f0c2a9e:<sha256>".
+type ContentHasher struct {
+	fields  []string
+	version string
+}
+
+// NewContentHasher hashes the given fields, in a fixed order.
+func NewContentHasher(fields []string) (*ContentHasher, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no hash fields configured")
+	}
+	sorted := make([]string, 0, len(fields))
+	seen := make(map[string]bool)
+	for _, f := range fields {
+		if _, ok := hashFieldValues[f]; !ok {
+			return nil, fmt.Errorf("unknown hash field: %s", f)
+		}
+		if !seen[f] {
+			seen[f] = true
+			sorted = append(sorted, f)
+		}
+	}
+	sort.Strings(sorted)
+
+	spec := sha256.Sum256([]byte(hashNormalization + ":" + strings.Join(sorted, ",")))
+	return &ContentHasher{fields: sorted, version: "h1-" + hex.EncodeToString(spec[:4])}, nil
+}
+
+// Version identifies the field set and normalization.
+func (h *ContentHasher) Version() string {
+	return h.version
+}
+
+func (h *ContentHasher) Hash(a *domain.Article) string {
+	hasher := sha256.New()
+	for _, f := range h.fields {
+		hasher.Write([]byte(f))
+		hasher.Write([]byte{0})
+		hasher.Write([]byte(hashFieldValues[f](a)))
+		hasher.Write([]byte{0})
+	}
+	return h.version + ":" + hex.EncodeToString(hasher.Sum(nil))
+}
+
+// hashVersion returns the version prefix of a stored hash; legacy hashes from
+// Article.ComputeHash have none.
+func hashVersion(hash string) string {
+	version, _, found := strings.Cut(hash, ":")
+	if !found {
+		return ""
+	}
+	return version
+}
+
+// mediaURLs lists a media item's URLs, galleries' items included, without
+// their query.
+func mediaURLs(m domain.Media) []string {
+	var urls []string
+	if m.URL != "" {
+		urls = append(urls, withoutQuery(m.URL))
+	}
+	for _, item := range m.Items {
+		urls = append(urls, mediaURLs(item)...)
+	}
+	return urls
+}
+
+// withoutQuery drops the query and fragment of a URL, where cache busters live.
+func withoutQuery(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentHasher_Hash(t *testing.T) {
+	hasher, err := NewContentHasher(DefaultHashFields)
+	require.NoError(t, err)
+
+	base := domain.Article{
+		Source: "pulselive", URL: "https://www.ecb.co.uk/news/1", Title: "England win at Lord's",
+		Body: "<p>England won by 45 runs.</p>", ImageURL: "https://resources.ecb.co.uk/photo.jpg?v=1",
+		Tags: []domain.Tag{{ID: 1, Label: "England"}, {ID: 2, Label: "Ashes"}},
+	}
+	hash := hasher.Hash(&base)
+	assert.Equal(t, hasher.Version(), hashVersion(hash))
+
+	same := base
+	same.Title = "  England win at Lord&#39;s \n"
+	same.Body = "<p>England  won by 45 runs.</p>\n"
+	same.ImageURL = "https://resources.ecb.co.uk/photo.jpg?v=2"
+	same.Tags = []domain.Tag{{ID: 2, Label: "Ashes"}, {ID: 1, Label: "England"}}
+	assert.Equal(t, hash, hasher.Hash(&same), "whitespace, entities, cache busters and tag order are not changes")
+
+	retagged := base
+	retagged.Tags = append([]domain.Tag{{ID: 3, Label: "Root"}}, base.Tags...)
+	assert.NotEqual(t, hash, hasher.Hash(&retagged))
+
+	titleOnly, err := NewContentHasher([]string{"title"})
+	require.NoError(t, err)
+	assert.NotEqual(t, hasher.Version(), titleOnly.Version())
+	assert.Equal(t, titleOnly.Hash(&base), titleOnly.Hash(&retagged))
+}
+
+func TestNewContentHasher_Invalid(t *testing.T) {
+	_, err := NewContentHasher([]string{"title", "colour"})
+	assert.ErrorContains(t, err, "unknown hash field: colour")
+	_, err = NewContentHasher(nil)
+	assert.Error(t, err)
+}
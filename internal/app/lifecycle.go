@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+)
+
+// LifecycleTracker moves articles through their lifecycle states, as the
+// crawler persists and publishes them and the CMS sync consumes their events.
+type LifecycleTracker struct {
+	store domain.LifecycleStore
+}
+
+func NewLifecycleTracker(store domain.LifecycleStore) *LifecycleTracker {
+	return &LifecycleTracker{store: store}
+}
+
+// Record moves the stored articles among ids to state to, as of at. Articles
+// already in it, or whose state changed after at, are left as they are, so
+// late records do not undo newer ones. errMsg is kept with sync failures.
+func (t *LifecycleTracker) Record(ctx context.Context, ids []string, to domain.LifecycleState, at time.Time, errMsg string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	current, err := t.store.GetLifecycles(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load lifecycles: %w", err)
+	}
+
+	var transitions []domain.LifecycleTransition
+	for _, id := range ids {
+		cur, ok := current[id]
+		if !ok || cur.State == to || at.Before(cur.ChangedAt) {
+			continue
+		}
+		if !cur.State.CanTransition(to) {
+			slog.Warn("Lifecycle transition not allowed", "id", id, "from", cur.State, "to", to)
+			metrics.LifecycleTransitionsRejected.WithLabelValues(string(cur.State), string(to)).Inc()
+			continue
+		}
+		transitions = append(transitions, domain.LifecycleTransition{
+			ID:        fmt.Sprintf("%s:%d:%s", id, at.UnixNano(), to),
+			ArticleID: id,
+			From:      cur.State,
+			To:        to,
+			Error:     errMsg,
+			At:        at,
+		})
+	}
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	applied, err := t.store.ApplyTransitions(ctx, transitions)
+	for _, tr := range applied {
+		metrics.LifecycleTransitions.WithLabelValues(string(tr.From), string(tr.To)).Inc()
+	}
+	return err
+}
+
+// ListByState returns the articles in a state, most recently changed first.
+func (t *LifecycleTracker) ListByState(ctx context.Context, state domain.LifecycleState, limit int) ([]domain.Article, error) {
+	if !state.Valid() {
+		return nil, fmt.Errorf("unknown lifecycle state %q: %w", state, domain.ErrInvalidInput)
+	}
+	return t.store.FindByLifecycleState(ctx, state, limit)
+}
+
+// ListTransitions returns an article's lifecycle transitions, newest first.
+func (t *LifecycleTracker) ListTransitions(ctx context.Context, articleID string, limit int) ([]domain.LifecycleTransition, error) {
+	return t.store.ListTransitions(ctx, articleID, limit)
+}
+
+// LifecyclePublisher records the articles it publishes as published, and
+// tombstones as withdrawn.
+type LifecyclePublisher struct {
+	events    domain.EventProducer
+	lifecycle *LifecycleTracker
+	now       func() time.Time
+}
+
+func NewLifecyclePublisher(events domain.EventProducer, lifecycle *LifecycleTracker) *LifecyclePublisher {
+	return &LifecyclePublisher{events: events, lifecycle: lifecycle, now: time.Now}
+}
+
+func (p *LifecyclePublisher) Publish(ctx context.Context, article *domain.Article) error {
+	at := p.now()
+	if err := p.events.Publish(ctx, article); err != nil {
+		return err
+	}
+	p.record(ctx, []domain.Article{*article}, at)
+	return nil
+}
+
+func (p *LifecyclePublisher) PublishBatch(ctx context.Context, articles []domain.Article) error {
+	at := p.now()
+	if err := p.events.PublishBatch(ctx, articles); err != nil {
+		return err
+	}
+	p.record(ctx, articles, at)
+	return nil
+}
+
+// record moves published articles on. The events are out, so failing to
+// record it is logged rather than returned.
+func (p *LifecyclePublisher) record(ctx context.Context, articles []domain.Article, at time.Time) {
+	var published, withdrawn []string
+	for _, a := range articles {
+		if a.WithdrawnAt != nil {
+			withdrawn = append(withdrawn, a.ID)
+		} else {
+			published = append(published, a.ID)
+		}
+	}
+	if err := p.lifecycle.Record(ctx, published, domain.LifecyclePublished, at, ""); err != nil {
+		slog.Error("Failed to record published articles", "count", len(published), "error", err)
+	}
+	if err := p.lifecycle.Record(ctx, withdrawn, domain.LifecycleWithdrawn, at, ""); err != nil {
+		slog.Error("Failed to record withdrawn articles", "count", len(withdrawn), "error", err)
+	}
+}
+
+func (p *LifecyclePublisher) Close() error {
+	return p.events.Close()
+}
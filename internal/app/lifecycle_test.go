@@ -0,0 +1,127 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryLifecycles struct {
+	states      map[string]domain.ArticleLifecycle
+	transitions []domain.LifecycleTransition
+}
+
+func (m *memoryLifecycles) GetLifecycles(_ context.Context, ids []string) (map[string]domain.ArticleLifecycle, error) {
+	out := map[string]domain.ArticleLifecycle{}
+	for _, id := range ids {
+		if l, ok := m.states[id]; ok {
+			out[id] = l
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryLifecycles) ApplyTransitions(_ context.Context, transitions []domain.LifecycleTransition) ([]domain.LifecycleTransition, error) {
+	var applied []domain.LifecycleTransition
+	for _, t := range transitions {
+		if l, ok := m.states[t.ArticleID]; ok && l.State == t.From {
+			m.states[t.ArticleID] = domain.ArticleLifecycle{State: t.To, ChangedAt: t.At, Error: t.Error}
+			applied = append(applied, t)
+		}
+	}
+	m.transitions = append(m.transitions, applied...)
+	return applied, nil
+}
+
+func (m *memoryLifecycles) FindByLifecycleState(_ context.Context, state domain.LifecycleState, _ int) ([]domain.Article, error) {
+	var out []domain.Article
+	for id, l := range m.states {
+		if l.State == state {
+			out = append(out, domain.Article{ID: id})
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryLifecycles) ListTransitions(_ context.Context, articleID string, _ int) ([]domain.LifecycleTransition, error) {
+	var out []domain.LifecycleTransition
+	for i := len(m.transitions) - 1; i >= 0; i-- {
+		if m.transitions[i].ArticleID == articleID {
+			out = append(out, m.transitions[i])
+		}
+	}
+	return out, nil
+}
+
+func TestLifecycleTrackerRecord(t *testing.T) {
+	now := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	store := &memoryLifecycles{states: map[string]domain.ArticleLifecycle{
+		"new":    {},
+		"synced": {State: domain.LifecycleSynced, ChangedAt: now.Add(-time.Hour)},
+		"later":  {State: domain.LifecycleSynced, ChangedAt: now.Add(time.Second)},
+	}}
+	tracker := NewLifecycleTracker(store)
+	ctx := context.Background()
+
+	require.NoError(t, tracker.Record(ctx, []string{"new", "synced", "later", "missing"}, domain.LifecycleIngested, now, ""))
+	assert.Equal(t, domain.LifecycleIngested, store.states["new"].State)
+	assert.Equal(t, domain.LifecycleIngested, store.states["synced"].State)
+	assert.Equal(t, domain.LifecycleSynced, store.states["later"].State, "changed after the record was made")
+	_, stored := store.states["missing"]
+	assert.False(t, stored)
+
+	// Synced articles must be published again before they can fail to sync
+	require.NoError(t, tracker.Record(ctx, []string{"later"}, domain.LifecycleSyncFailed, now.Add(time.Minute), "cms down"))
+	assert.Equal(t, domain.LifecycleSynced, store.states["later"].State)
+
+	require.NoError(t, tracker.Record(ctx, []string{"new"}, domain.LifecycleSyncFailed, now.Add(time.Minute), "cms down"))
+	assert.Equal(t, domain.ArticleLifecycle{State: domain.LifecycleSyncFailed, ChangedAt: now.Add(time.Minute), Error: "cms down"}, store.states["new"])
+
+	transitions, err := tracker.ListTransitions(ctx, "new", 10)
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	assert.Equal(t, domain.LifecycleIngested, transitions[0].From)
+	assert.Equal(t, domain.LifecycleSyncFailed, transitions[0].To)
+	assert.Empty(t, transitions[1].From, "untracked before")
+
+	_, err = tracker.ListByState(ctx, "lost", 10)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+type failingProducer struct{ recordingProducer }
+
+func (p *failingProducer) PublishBatch(context.Context, []domain.Article) error {
+	return errors.New("broker unavailable")
+}
+
+func TestLifecyclePublisher(t *testing.T) {
+	now := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	withdrawnAt := now.Add(-time.Minute)
+	store := &memoryLifecycles{states: map[string]domain.ArticleLifecycle{
+		"a":    {State: domain.LifecycleIngested, ChangedAt: now.Add(-time.Hour)},
+		"b":    {State: domain.LifecycleSynced, ChangedAt: now.Add(-time.Hour)},
+		"gone": {State: domain.LifecycleSynced, ChangedAt: now.Add(-time.Hour)},
+	}}
+	tracker := NewLifecycleTracker(store)
+	ctx := context.Background()
+
+	failing := NewLifecyclePublisher(&failingProducer{}, tracker)
+	assert.Error(t, failing.PublishBatch(ctx, []domain.Article{{ID: "a"}}))
+	assert.Equal(t, domain.LifecycleIngested, store.states["a"].State, "nothing was published")
+
+	events := &recordingProducer{}
+	publisher := NewLifecyclePublisher(events, tracker)
+	publisher.now = func() time.Time { return now }
+	require.NoError(t, publisher.PublishBatch(ctx, []domain.Article{{ID: "a"}, {ID: "gone", WithdrawnAt: &withdrawnAt}}))
+	require.NoError(t, publisher.Publish(ctx, &domain.Article{ID: "b"}))
+
+	assert.Len(t, events.published, 3)
+	assert.Equal(t, domain.LifecyclePublished, store.states["a"].State)
+	assert.Equal(t, domain.LifecyclePublished, store.states["b"].State)
+	assert.Equal(t, domain.LifecycleWithdrawn, store.states["gone"].State)
+}
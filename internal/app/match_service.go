@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// MatchService answers queries about stored fixtures and results.
+type MatchService struct {
+	matches   domain.MatchStore
+	articles  domain.MatchArticleReader
+	overrides *Overrides
+}
+
+func NewMatchService(matches domain.MatchStore, articles domain.MatchArticleReader, overrides *Overrides) *MatchService {
+	return &MatchService{matches: matches, articles: articles, overrides: overrides}
+}
+
+// FindMatches returns the matches selected by the query, by start time.
+func (s *MatchService) FindMatches(ctx context.Context, query domain.MatchQuery) ([]domain.Match, error) {
+	return s.matches.FindMatches(ctx, query)
+}
+
+func (s *MatchService) GetMatch(ctx context.Context, id string) (*domain.Match, error) {
+	return s.matches.GetMatch(ctx, id)
+}
+
+// ListArticles returns the articles linked to a match, newest first, with
+// their overrides applied.
+func (s *MatchService) ListArticles(ctx context.Context, matchID string, limit int) ([]domain.Article, error) {
+	if _, err := s.matches.GetMatch(ctx, matchID); err != nil {
+		return nil, err
+	}
+	articles, err := s.articles.FindArticlesByMatch(ctx, matchID, limit)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.overrides.Apply(ctx, articles); err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
@@ -0,0 +1,233 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// maxMatchCandidates bounds the matches loaded to link one batch of articles.
+const maxMatchCandidates = 1000
+
+// MatchIngestion persists crawled fixtures and results and publishes the new
+// and changed ones.
+type MatchIngestion struct {
+	store  domain.MatchStore
+	events domain.MatchEventPublisher
+}
+
+func NewMatchIngestion(store domain.MatchStore, events domain.MatchEventPublisher) *MatchIngestion {
+	return &MatchIngestion{store: store, events: events}
+}
+
+// ProcessBatch stores one page of a match provider's feed.
+func (m *MatchIngestion) ProcessBatch(ctx context.Context, provider string, matches []domain.Match) error {
+	start := time.Now()
+
+	unique := make([]domain.Match, 0, len(matches))
+	seen := make(map[string]bool)
+	for _, match := range matches {
+		if match.ID == "" || seen[match.ID] {
+			continue
+		}
+		seen[match.ID] = true
+		match.Provider = provider
+		match.LastSeenAt = start
+		match.ContentHash = match.ComputeHash()
+		unique = append(unique, match)
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(unique))
+	for i, match := range unique {
+		ids[i] = match.ID
+	}
+	existing, err := m.store.GetMatchHashes(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to fetch match hashes: %w", err)
+	}
+
+	var changed []domain.Match
+	for _, match := range unique {
+		oldHash, exists := existing[match.ID]
+		switch {
+		case !exists:
+			metrics.MatchesIngested.WithLabelValues(provider, "new").Inc()
+			changed = append(changed, match)
+		case oldHash != match.ContentHash:
+			metrics.MatchesIngested.WithLabelValues(provider, "changed").Inc()
+			changed = append(changed, match)
+		default:
+			metrics.MatchesIngested.WithLabelValues(provider, "unchanged").Inc()
+		}
+	}
+
+	if err := m.store.BulkUpsertMatches(ctx, unique); err != nil {
+		return fmt.Errorf("bulk upsert of matches failed: %w", err)
+	}
+
+	if len(changed) > 0 {
+		slog.Info("Publishing changed matches", "count", len(changed), "provider", provider)
+		if err := m.events.PublishMatches(ctx, changed); err != nil {
+			// Continue even if publish fails, data is in DB
+			slog.Error("Error publishing matches", "count", len(changed), "error", err)
+			metrics.PublishErrors.WithLabelValues(provider).Inc()
+		}
+	}
+	return nil
+}
+
+// processMatchProvider runs one crawl of a fixtures and results feed.
+func (s *NewsCrawlerService) processMatchProvider(ctx context.Context, provider domain.MatchProvider) {
+	tr := otel.Tracer("news-crawler")
+	ctx, span := tr.Start(ctx, "processMatchProvider")
+	defer span.End()
+
+	name := provider.GetName()
+	slog.Debug("Starting crawl for match provider", "provider", name)
+	span.SetAttributes(attribute.String("provider", name))
+
+	s.runCrawl(ctx, span, name, func(crawlCtx context.Context) error {
+		return provider.Crawl(crawlCtx, func(matches []domain.Match) error {
+			return s.matchIngestion.ProcessBatch(ctx, name, matches)
+		})
+	})
+}
+
+// MatchLinker links articles to the matches they cover. References from the
+// feed are kept; other articles are linked to the match between the teams
+// they name that starts closest to their publication.
+type MatchLinker struct {
+	store  domain.MatchStore
+	window time.Duration // How far from publication a linked match may start
+}
+
+func NewMatchLinker(store domain.MatchStore, window time.Duration) (*MatchLinker, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("match link window must be positive")
+	}
+	return &MatchLinker{store: store, window: window}, nil
+}
+
+// Link sets MatchIDs on the articles without a reference from their feed.
+func (l *MatchLinker) Link(ctx context.Context, articles []*domain.Article) error {
+	var pending []*domain.Article
+	var from, to time.Time
+	for _, a := range articles {
+		if len(a.MatchIDs) > 0 {
+			metrics.ArticlesMatchLinked.WithLabelValues(a.Provider, "feed").Inc()
+			continue
+		}
+		if a.PublishedAt.IsZero() || len(articleTeams(a)) < 2 {
+			continue
+		}
+		pending = append(pending, a)
+		if from.IsZero() || a.PublishedAt.Before(from) {
+			from = a.PublishedAt
+		}
+		if a.PublishedAt.After(to) {
+			to = a.PublishedAt
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	candidates, err := l.store.FindMatches(ctx, domain.MatchQuery{
+		From:  from.Add(-l.window),
+		To:    to.Add(l.window),
+		Limit: maxMatchCandidates,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find matches: %w", err)
+	}
+
+	for _, a := range pending {
+		if match := l.closest(a, candidates); match != nil {
+			a.MatchIDs = []string{match.ID}
+			metrics.ArticlesMatchLinked.WithLabelValues(a.Provider, "inferred").Inc()
+		}
+	}
+	return nil
+}
+
+// closest returns the match of the article's teams starting nearest to its
+// publication within the window, or nil.
+func (l *MatchLinker) closest(a *domain.Article, candidates []domain.Match) *domain.Match {
+	teams := articleTeams(a)
+	var best *domain.Match
+	var bestGap time.Duration
+	for i := range candidates {
+		m := &candidates[i]
+		if a.Sport != "" && m.Sport != "" && a.Sport != m.Sport {
+			continue
+		}
+		gap := a.PublishedAt.Sub(m.StartsAt).Abs()
+		if gap > l.window || !playedBy(m, teams) {
+			continue
+		}
+		if best == nil || gap < bestGap {
+			best, bestGap = m, gap
+		}
+	}
+	return best
+}
+
+// articleTeams returns the lower-cased names of the teams an article reports
+// the result of or, without one, the teams it mentions.
+func articleTeams(a *domain.Article) []string {
+	var teams []string
+	if a.MatchResult != nil {
+		for _, t := range a.MatchResult.Teams {
+			teams = append(teams, strings.ToLower(t.Team))
+		}
+		return teams
+	}
+	for _, e := range a.Entities {
+		if e.Type == domain.EntityTeam {
+			teams = append(teams, strings.ToLower(e.Name))
+		}
+	}
+	return teams
+}
+
+// playedBy reports whether both sides of the match are among the teams.
+func playedBy(m *domain.Match, teams []string) bool {
+	if len(m.Teams) < 2 {
+		return false
+	}
+	for _, side := range m.Teams {
+		found := false
+		for _, t := range teams {
+			if sameTeam(strings.ToLower(side.Name), t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// sameTeam matches names equal or extending each other by whole words, e.g.
+// "england" and "england men".
+func sameTeam(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	return a == b || strings.HasPrefix(b, a+" ")
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryMatches map[string]domain.Match
+
+func (m memoryMatches) GetMatchHashes(_ context.Context, ids []string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, id := range ids {
+		if match, ok := m[id]; ok {
+			out[id] = match.ContentHash
+		}
+	}
+	return out, nil
+}
+
+func (m memoryMatches) BulkUpsertMatches(_ context.Context, matches []domain.Match) error {
+	for _, match := range matches {
+		m[match.ID] = match
+	}
+	return nil
+}
+
+func (m memoryMatches) GetMatch(_ context.Context, id string) (*domain.Match, error) {
+	match, ok := m[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &match, nil
+}
+
+func (m memoryMatches) FindMatches(_ context.Context, q domain.MatchQuery) ([]domain.Match, error) {
+	var out []domain.Match
+	for _, match := range m {
+		if !match.StartsAt.Before(q.From) && !match.StartsAt.After(q.To) {
+			out = append(out, match)
+		}
+	}
+	return out, nil
+}
+
+type recordingMatches struct {
+	published []domain.Match
+}
+
+func (r *recordingMatches) PublishMatches(_ context.Context, matches []domain.Match) error {
+	r.published = append(r.published, matches...)
+	return nil
+}
+
+func TestMatchIngestion_PublishesNewAndChanged(t *testing.T) {
+	store := memoryMatches{}
+	events := &recordingMatches{}
+	ingestion := NewMatchIngestion(store, events)
+	ctx := context.Background()
+
+	fixture := domain.Match{ID: "m1", Title: "England v India", Status: domain.MatchScheduled}
+	require.NoError(t, ingestion.ProcessBatch(ctx, "ecb-fixtures", []domain.Match{fixture, fixture}))
+	require.Len(t, events.published, 1, "duplicates within a page are published once")
+	assert.Equal(t, "ecb-fixtures", store["m1"].Provider)
+
+	require.NoError(t, ingestion.ProcessBatch(ctx, "ecb-fixtures", []domain.Match{fixture}))
+	assert.Len(t, events.published, 1, "unchanged matches are not republished")
+
+	fixture.Status, fixture.Winner = domain.MatchCompleted, "England"
+	require.NoError(t, ingestion.ProcessBatch(ctx, "ecb-fixtures", []domain.Match{fixture}))
+	require.Len(t, events.published, 2)
+	assert.Equal(t, "England", events.published[1].Winner)
+}
+
+func TestMatchLinker(t *testing.T) {
+	start := time.Date(2026, 7, 10, 10, 0, 0, 0, time.UTC)
+	store := memoryMatches{
+		"first":  {ID: "first", Sport: "cricket", StartsAt: start, Teams: []domain.MatchTeam{{Name: "England"}, {Name: "West Indies"}}},
+		"second": {ID: "second", Sport: "cricket", StartsAt: start.Add(8 * 24 * time.Hour), Teams: []domain.MatchTeam{{Name: "England"}, {Name: "West Indies"}}},
+		"women":  {ID: "women", Sport: "cricket", StartsAt: start, Teams: []domain.MatchTeam{{Name: "England Women"}, {Name: "India Women"}}},
+	}
+	linker, err := NewMatchLinker(store, 36*time.Hour)
+	require.NoError(t, err)
+
+	report := &domain.Article{ID: "a1", PublishedAt: start.Add(30 * time.Hour), MatchResult: &domain.MatchResult{
+		Teams: []domain.TeamScore{{Team: "England Men"}, {Team: "West Indies"}},
+	}}
+	preview := &domain.Article{ID: "a2", PublishedAt: start.Add(-12 * time.Hour), Entities: []domain.EntityRef{
+		{Type: domain.EntityTeam, Name: "England Women"}, {Type: domain.EntityTeam, Name: "India Women"},
+	}}
+	referenced := &domain.Article{ID: "a3", PublishedAt: start, MatchIDs: []string{"second"}}
+	unrelated := &domain.Article{ID: "a4", PublishedAt: start, Entities: []domain.EntityRef{
+		{Type: domain.EntityTeam, Name: "England"}, {Type: domain.EntityTeam, Name: "Australia"},
+	}}
+
+	require.NoError(t, linker.Link(context.Background(), []*domain.Article{report, preview, referenced, unrelated}))
+
+	assert.Equal(t, []string{"first"}, report.MatchIDs, "names extending a team's name match it")
+	assert.Equal(t, []string{"women"}, preview.MatchIDs)
+	assert.Equal(t, []string{"second"}, referenced.MatchIDs, "references from the feed are kept")
+	assert.Empty(t, unrelated.MatchIDs, "both sides must be named")
+}
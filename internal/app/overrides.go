@@ -0,0 +1,295 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// overrideField reads and writes one overridable field on overrides and articles.
+type overrideField struct {
+	name    string
+	value   func(*domain.OverrideFields) any // Nil when not overridden
+	current func(*domain.Article) any
+	copy    func(dst, src *domain.OverrideFields) bool
+	clear   func(*domain.OverrideFields)
+	apply   func(*domain.OverrideFields, *domain.Article)
+}
+
+func fieldOf[T any](name string, field func(*domain.OverrideFields) **T, article func(*domain.Article) *T) overrideField {
+	return overrideField{
+		name: name,
+		value: func(f *domain.OverrideFields) any {
+			if p := *field(f); p != nil {
+				return *p
+			}
+			return nil
+		},
+		current: func(a *domain.Article) any { return *article(a) },
+		copy: func(dst, src *domain.OverrideFields) bool {
+			p := *field(src)
+			if p != nil {
+				*field(dst) = p
+			}
+			return p != nil
+		},
+		clear: func(f *domain.OverrideFields) { *field(f) = nil },
+		apply: func(f *domain.OverrideFields, a *domain.Article) {
+			if p := *field(f); p != nil {
+				*article(a) = *p
+			}
+		},
+	}
+}
+
+// overrideFields are the fields editors may override, by their JSON name.
+var overrideFields = []overrideField{
+	fieldOf("title", func(f *domain.OverrideFields) **string { return &f.Title }, func(a *domain.Article) *string { return &a.Title }),
+	fieldOf("description", func(f *domain.OverrideFields) **string { return &f.Description }, func(a *domain.Article) *string { return &a.Description }),
+	fieldOf("summary", func(f *domain.OverrideFields) **string { return &f.Summary }, func(a *domain.Article) *string { return &a.Summary }),
+	fieldOf("image_url", func(f *domain.OverrideFields) **string { return &f.ImageURL }, func(a *domain.Article) *string { return &a.ImageURL }),
+	fieldOf("tags", func(f *domain.OverrideFields) **[]domain.Tag { return &f.Tags }, func(a *domain.Article) *[]domain.Tag { return &a.Tags }),
+	fieldOf("canonical_tags", func(f *domain.OverrideFields) **[]domain.CanonicalTag { return &f.CanonicalTags }, func(a *domain.Article) *[]domain.CanonicalTag { return &a.CanonicalTags }),
+	fieldOf("sport", func(f *domain.OverrideFields) **string { return &f.Sport }, func(a *domain.Article) *string { return &a.Sport }),
+	fieldOf("category", func(f *domain.OverrideFields) **string { return &f.Category }, func(a *domain.Article) *string { return &a.Category }),
+}
+
+func findOverrideField(name string) (overrideField, bool) {
+	for _, f := range overrideFields {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return overrideField{}, false
+}
+
+// Overrides applies the editors' overrides to articles leaving the service.
+type Overrides struct {
+	store domain.OverrideStore
+}
+
+func NewOverrides(store domain.OverrideStore) *Overrides {
+	return &Overrides{store: store}
+}
+
+// Apply overwrites the overridden fields of the articles. Withdrawn articles
+// are left alone: their events are tombstones.
+func (o *Overrides) Apply(ctx context.Context, articles []domain.Article) error {
+	ids := make([]string, 0, len(articles))
+	for _, a := range articles {
+		if a.WithdrawnAt == nil {
+			ids = append(ids, a.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	overrides, err := o.store.GetOverrides(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load overrides: %w", err)
+	}
+	for i := range articles {
+		a := &articles[i]
+		override, ok := overrides[a.ID]
+		if !ok || a.WithdrawnAt != nil {
+			continue
+		}
+		for _, f := range overrideFields {
+			f.apply(&override.Fields, a)
+		}
+	}
+	return nil
+}
+
+// OverridePublisher applies overrides to articles before publishing them, so
+// every event carries the editors' corrections.
+type OverridePublisher struct {
+	events    domain.EventProducer
+	overrides *Overrides
+}
+
+func NewOverridePublisher(events domain.EventProducer, overrides *Overrides) *OverridePublisher {
+	return &OverridePublisher{events: events, overrides: overrides}
+}
+
+func (p *OverridePublisher) Publish(ctx context.Context, article *domain.Article) error {
+	articles := []domain.Article{*article}
+	if err := p.overrides.Apply(ctx, articles); err != nil {
+		return err
+	}
+	return p.events.Publish(ctx, &articles[0])
+}
+
+// PublishBatch publishes copies of the articles; the caller's are unchanged.
+func (p *OverridePublisher) PublishBatch(ctx context.Context, articles []domain.Article) error {
+	overridden := append([]domain.Article(nil), articles...)
+	if err := p.overrides.Apply(ctx, overridden); err != nil {
+		return err
+	}
+	return p.events.PublishBatch(ctx, overridden)
+}
+
+func (p *OverridePublisher) Close() error {
+	return p.events.Close()
+}
+
+// OverrideService sets and clears article overrides, keeping an audit trail,
+// and republishes the article with the change.
+type OverrideService struct {
+	store    domain.OverrideStore
+	articles domain.ArticleLookup
+	events   domain.EventProducer // Applies overrides to what it publishes
+	now      func() time.Time
+}
+
+func NewOverrideService(store domain.OverrideStore, articles domain.ArticleLookup, events domain.EventProducer) *OverrideService {
+	return &OverrideService{store: store, articles: articles, events: events, now: time.Now}
+}
+
+// Get returns the article's override.
+func (s *OverrideService) Get(ctx context.Context, articleID string) (*domain.ArticleOverride, error) {
+	found, err := s.store.GetOverrides(ctx, []string{articleID})
+	if err != nil {
+		return nil, err
+	}
+	override, ok := found[articleID]
+	if !ok {
+		return nil, fmt.Errorf("override of article %s: %w", articleID, domain.ErrNotFound)
+	}
+	return &override, nil
+}
+
+// Set overrides the fields set in fields, keeping the article's other overrides.
+func (s *OverrideService) Set(ctx context.Context, articleID string, fields domain.OverrideFields, editor, reason string) (*domain.ArticleOverride, error) {
+	if fields.IsZero() {
+		return nil, fmt.Errorf("%w: no field to override", domain.ErrInvalidInput)
+	}
+	return s.change(ctx, articleID, domain.OverrideSet, editor, reason, func(override *domain.OverrideFields) []string {
+		var changed []string
+		for _, f := range overrideFields {
+			if f.copy(override, &fields) {
+				changed = append(changed, f.name)
+			}
+		}
+		return changed
+	})
+}
+
+// Clear removes the named overrides, or all of them when names is empty, so
+// the crawled values are in effect again.
+func (s *OverrideService) Clear(ctx context.Context, articleID string, names []string, editor, reason string) (*domain.ArticleOverride, error) {
+	for _, name := range names {
+		if _, ok := findOverrideField(name); !ok {
+			return nil, fmt.Errorf("%w: field %q cannot be overridden", domain.ErrInvalidInput, name)
+		}
+	}
+	return s.change(ctx, articleID, domain.OverrideClear, editor, reason, func(override *domain.OverrideFields) []string {
+		var cleared []string
+		for _, f := range overrideFields {
+			if f.value(override) == nil || (len(names) > 0 && !contains(names, f.name)) {
+				continue
+			}
+			f.clear(override)
+			cleared = append(cleared, f.name)
+		}
+		return cleared
+	})
+}
+
+// ListAudit returns the changes to an article's overrides, newest first.
+func (s *OverrideService) ListAudit(ctx context.Context, articleID string, limit int) ([]domain.OverrideAudit, error) {
+	return s.store.ListOverrideAudit(ctx, articleID, limit)
+}
+
+// change applies edit to the article's stored override, saves it with an
+// audit entry per changed field and republishes the article.
+func (s *OverrideService) change(ctx context.Context, articleID string, action domain.OverrideAction, editor, reason string, edit func(*domain.OverrideFields) []string) (*domain.ArticleOverride, error) {
+	found, err := s.articles.GetArticles(ctx, []string{articleID})
+	if err != nil {
+		return nil, err
+	}
+	article, ok := found[articleID]
+	if !ok {
+		return nil, fmt.Errorf("article %s: %w", articleID, domain.ErrNotFound)
+	}
+	stored, err := s.store.GetOverrides(ctx, []string{articleID})
+	if err != nil {
+		return nil, err
+	}
+
+	override := stored[articleID]
+	before := override.Fields
+	changed := edit(&override.Fields)
+	if len(changed) == 0 {
+		return &override, nil
+	}
+
+	now := s.now()
+	override.ArticleID = articleID
+	override.UpdatedBy = strings.TrimSpace(editor)
+	override.UpdatedAt = now
+
+	audit := make([]domain.OverrideAudit, 0, len(changed))
+	for _, name := range changed {
+		f, _ := findOverrideField(name)
+		old := f.value(&before)
+		if old == nil {
+			old = f.current(&article)
+		}
+		entry := domain.OverrideAudit{
+			ID:        fmt.Sprintf("%s:%d:%s", articleID, now.UnixNano(), name),
+			ArticleID: articleID,
+			Field:     name,
+			Action:    action,
+			Old:       auditValue(old),
+			Editor:    override.UpdatedBy,
+			Reason:    strings.TrimSpace(reason),
+			At:        now,
+		}
+		if action == domain.OverrideSet {
+			entry.New = auditValue(f.value(&override.Fields))
+		}
+		audit = append(audit, entry)
+	}
+
+	if err := s.store.SaveOverride(ctx, override); err != nil {
+		return nil, err
+	}
+	if err := s.store.AppendOverrideAudit(ctx, audit); err != nil {
+		return nil, err
+	}
+	slog.Info("Article override changed", "id", articleID, "action", action, "fields", changed, "editor", override.UpdatedBy)
+
+	// Held and withdrawn articles get the override when their next event is due
+	if !article.EmbargoHeld && !article.Review.Withholds() && article.WithdrawnAt == nil {
+		article.ChangedFields = changed
+		if err := s.events.Publish(ctx, &article); err != nil {
+			slog.Error("Failed to publish overridden article", "id", articleID, "error", err)
+		}
+	}
+	return &override, nil
+}
+
+// auditValue encodes a field value for the audit trail.
+func auditValue(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
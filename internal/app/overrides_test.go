@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryOverrides struct {
+	overrides map[string]domain.ArticleOverride
+	audit     []domain.OverrideAudit
+}
+
+func (m *memoryOverrides) GetOverrides(_ context.Context, ids []string) (map[string]domain.ArticleOverride, error) {
+	out := map[string]domain.ArticleOverride{}
+	for _, id := range ids {
+		if o, ok := m.overrides[id]; ok {
+			out[id] = o
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryOverrides) SaveOverride(_ context.Context, o domain.ArticleOverride) error {
+	if o.Fields.IsZero() {
+		delete(m.overrides, o.ArticleID)
+		return nil
+	}
+	m.overrides[o.ArticleID] = o
+	return nil
+}
+
+func (m *memoryOverrides) AppendOverrideAudit(_ context.Context, entries []domain.OverrideAudit) error {
+	m.audit = append(m.audit, entries...)
+	return nil
+}
+
+func (m *memoryOverrides) ListOverrideAudit(_ context.Context, articleID string, _ int) ([]domain.OverrideAudit, error) {
+	var out []domain.OverrideAudit
+	for i := len(m.audit) - 1; i >= 0; i-- {
+		if m.audit[i].ArticleID == articleID {
+			out = append(out, m.audit[i])
+		}
+	}
+	return out, nil
+}
+
+func TestOverrides_SurviveRecrawls(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryOverrides{overrides: map[string]domain.ArticleOverride{}}
+	articles := memoryArticles{"a1": {ID: "a1", Title: "Root hits centruy", Sport: "cricket"}}
+	producer := &recordingProducer{}
+	events := NewOverridePublisher(producer, NewOverrides(store))
+	service := NewOverrideService(store, articles, events)
+
+	title := "Root hits century"
+	_, err := service.Set(ctx, "a1", domain.OverrideFields{Title: &title}, "jane", "typo")
+	require.NoError(t, err)
+	require.Len(t, producer.published, 1, "the correction is published")
+	assert.Equal(t, title, producer.published[0].Title)
+	assert.Equal(t, []string{"title"}, producer.published[0].ChangedFields)
+
+	// A re-crawl publishes the feed's version
+	recrawled := []domain.Article{{ID: "a1", Title: "Root hits centruy again", Sport: "cricket"}}
+	require.NoError(t, events.PublishBatch(ctx, recrawled))
+	assert.Equal(t, title, producer.published[1].Title, "events carry the override")
+	assert.Equal(t, "Root hits centruy again", recrawled[0].Title, "the crawled article is not modified")
+
+	audit, err := service.ListAudit(ctx, "a1", 10)
+	require.NoError(t, err)
+	require.Len(t, audit, 1)
+	assert.Equal(t, domain.OverrideSet, audit[0].Action)
+	assert.JSONEq(t, `"Root hits centruy"`, string(audit[0].Old))
+	assert.JSONEq(t, `"Root hits century"`, string(audit[0].New))
+	assert.Equal(t, "jane", audit[0].Editor)
+
+	_, err = service.Clear(ctx, "a1", []string{"sport"}, "jane", "")
+	require.NoError(t, err)
+	assert.Len(t, producer.published, 2, "clearing a field not overridden changes nothing")
+	_, err = service.Clear(ctx, "a1", []string{"headline"}, "jane", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = service.Clear(ctx, "a1", nil, "sam", "reverted")
+	require.NoError(t, err)
+	assert.Empty(t, store.overrides)
+	assert.Equal(t, "Root hits centruy", producer.published[2].Title, "the crawled value is back")
+	assert.Len(t, store.audit, 2)
+}
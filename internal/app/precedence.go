@@ -0,0 +1,443 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+)
+
+// maxPrecedenceHops bounds how far duplicate_of links are followed to a
+// story's primary article; groups are kept one link deep.
+const maxPrecedenceHops = 4
+
+// SourcePrecedence keeps a single primary article per story when several
+// sources carry it, as near duplicates or under the same canonical URL. The
+// article of the highest ranked source is the primary, the first seen on
+// ties. The others are its secondaries: they point to it with DuplicateOf and
+// are listed in its Secondaries. Unless suppressed, their events are published
+// too, marked by DuplicateOf.
+type SourcePrecedence struct {
+	store    domain.PrecedenceStore
+	articles domain.ArticleLookup
+	events   domain.EventProducer
+	ranks    map[string]int // By provider; unlisted providers rank 0
+	suppress bool           // Secondaries are kept off the event stream
+	now      func() time.Time
+}
+
+func NewSourcePrecedence(store domain.PrecedenceStore, articles domain.ArticleLookup, events domain.EventProducer, ranks map[string]int, suppressSecondaries bool) *SourcePrecedence {
+	return &SourcePrecedence{store: store, articles: articles, events: events, ranks: ranks, suppress: suppressSecondaries, now: time.Now}
+}
+
+// Rank returns the precedence rank of a provider.
+func (p *SourcePrecedence) Rank(provider string) int {
+	return p.ranks[provider]
+}
+
+// ResolveURLConflicts decides between crawled articles and the stored
+// articles owning their canonical URL, by owner ID. It returns the owners of
+// the articles that outrank the stored story's primary and take it over; the
+// others are listed as secondaries of that primary once the returned plan is
+// applied, and must not be stored.
+func (p *SourcePrecedence) ResolveURLConflicts(ctx context.Context, articles []domain.Article, owners map[string]string) (map[string]string, *PrecedencePlan, error) {
+	plan := p.newPlan()
+	ownerIDs := make([]string, 0, len(owners))
+	for _, owner := range owners {
+		ownerIDs = append(ownerIDs, owner)
+	}
+	if err := plan.load(ctx, ownerIDs); err != nil {
+		return nil, nil, err
+	}
+
+	takeovers := make(map[string]string)
+	for i := range articles {
+		a := &articles[i]
+		owner, ok := owners[a.ID]
+		if !ok {
+			continue
+		}
+		primary := plan.primaryOf(owner, a.ID)
+		if primary == nil {
+			continue
+		}
+		// The URL moved to a secondary of the article's own story
+		if primary.ID == a.ID || p.Rank(a.Provider) > p.Rank(primary.Provider) {
+			takeovers[a.ID] = owner
+			continue
+		}
+		plan.absorb(primary, a)
+	}
+	return takeovers, plan, nil
+}
+
+// Resolve picks the primary article of the stories of new and changed
+// articles, whose DuplicateOf links them to another article of the story.
+// takeovers maps articles to the stored owner of their canonical URL. The
+// decisions are kept in memory on the articles and the returned plan until
+// it is applied. plan continues the plan of ResolveURLConflicts, if any.
+func (p *SourcePrecedence) Resolve(ctx context.Context, plan *PrecedencePlan, articles []*domain.Article, takeovers map[string]string) (*PrecedencePlan, error) {
+	if plan == nil {
+		plan = p.newPlan()
+	}
+	plan.add(articles)
+	ids := make([]string, 0, len(articles))
+	for _, a := range articles {
+		ids = append(ids, a.ID)
+		if a.DuplicateOf != "" {
+			ids = append(ids, a.DuplicateOf)
+		}
+		if owner, ok := takeovers[a.ID]; ok {
+			ids = append(ids, owner)
+		}
+	}
+	if err := plan.load(ctx, ids); err != nil {
+		return nil, err
+	}
+
+	// Crawled articles keep their stored secondaries and story
+	for _, a := range articles {
+		prev, stored := plan.previous[a.ID]
+		if stored && a.Secondaries == nil {
+			a.Secondaries = append([]domain.SecondarySource(nil), prev.Secondaries...)
+		}
+		if owner, ok := takeovers[a.ID]; ok {
+			a.DuplicateOf = owner
+			plan.urlOwners[a.ID] = owner
+		} else if a.DuplicateOf == "" && stored {
+			a.DuplicateOf = prev.DuplicateOf
+		}
+	}
+
+	for _, a := range articles {
+		if a.DuplicateOf == "" {
+			continue
+		}
+		primary := plan.primaryOf(a.DuplicateOf, a.ID)
+		if primary == nil || primary == a {
+			a.DuplicateOf = ""
+			continue
+		}
+		if p.Rank(a.Provider) > p.Rank(primary.Provider) {
+			slog.Info("Article takes precedence over the primary of its story", "id", a.ID, "provider", a.Provider, "previous_primary", primary.ID)
+			metrics.PrecedenceTakeovers.WithLabelValues(a.Provider).Inc()
+			plan.absorb(a, primary)
+		} else {
+			plan.absorb(primary, a)
+		}
+	}
+	return plan, nil
+}
+
+// PrecedencePlan holds the precedence decisions of a batch until they are
+// applied to the stored articles.
+type PrecedencePlan struct {
+	p         *SourcePrecedence
+	batch     map[string]*domain.Article // Crawled articles
+	stored    map[string]*domain.Article // Other stored articles, as changed by the plan
+	previous  map[string]domain.Article  // Stored versions, unchanged
+	urlOwners map[string]string          // Stored owner of the canonical URL of crawled articles
+	demotions []demotion
+	updated   []string                  // Stored primaries whose secondaries changed
+	demoted   map[string]domain.Article // Published primaries made secondaries, as stored
+}
+
+// demotion makes articles secondaries of a primary.
+type demotion struct {
+	ids     []string
+	primary string
+}
+
+func (p *SourcePrecedence) newPlan() *PrecedencePlan {
+	return &PrecedencePlan{
+		p:         p,
+		batch:     make(map[string]*domain.Article),
+		stored:    make(map[string]*domain.Article),
+		previous:  make(map[string]domain.Article),
+		urlOwners: make(map[string]string),
+		demoted:   make(map[string]domain.Article),
+	}
+}
+
+// add makes the crawled articles part of the plan. They replace the stored
+// versions loaded earlier, keeping the secondaries the plan listed on them.
+func (plan *PrecedencePlan) add(articles []*domain.Article) {
+	for _, a := range articles {
+		if stored, ok := plan.stored[a.ID]; ok {
+			if a.Secondaries == nil {
+				a.Secondaries = stored.Secondaries
+			}
+			delete(plan.stored, a.ID)
+		}
+		plan.batch[a.ID] = a
+	}
+}
+
+// load fetches the stored versions of ids and of the articles they link to.
+func (plan *PrecedencePlan) load(ctx context.Context, ids []string) error {
+	for hop := 0; hop < maxPrecedenceHops && len(ids) > 0; hop++ {
+		var missing []string
+		for _, id := range ids {
+			if _, ok := plan.previous[id]; !ok && id != "" {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		found, err := plan.p.articles.GetArticles(ctx, missing)
+		if err != nil {
+			return fmt.Errorf("failed to load story articles: %w", err)
+		}
+		ids = ids[:0]
+		for id, a := range found {
+			plan.previous[id] = a
+			if _, crawled := plan.batch[id]; !crawled {
+				stored := a
+				plan.stored[id] = &stored
+			}
+			if a.DuplicateOf != "" {
+				ids = append(ids, a.DuplicateOf)
+			}
+		}
+	}
+	return nil
+}
+
+func (plan *PrecedencePlan) lookup(id string) *domain.Article {
+	if a, ok := plan.batch[id]; ok {
+		return a
+	}
+	return plan.stored[id]
+}
+
+// primaryOf follows DuplicateOf links from id to the primary article of its
+// story. Reaching self, the article being resolved, makes it the primary.
+func (plan *PrecedencePlan) primaryOf(id, self string) *domain.Article {
+	current := plan.lookup(id)
+	for hop := 0; current != nil && current.DuplicateOf != "" && hop < maxPrecedenceHops; hop++ {
+		if current.ID == self {
+			break
+		}
+		next := plan.lookup(current.DuplicateOf)
+		if next == nil {
+			break
+		}
+		current = next
+	}
+	return current
+}
+
+// absorb makes loser and its secondaries secondaries of primary.
+func (plan *PrecedencePlan) absorb(primary, loser *domain.Article) {
+	secondaries := append(append([]domain.SecondarySource(nil), loser.Secondaries...), secondaryOf(loser))
+	merged, changed := mergeSecondaries(primary.ID, primary.Secondaries, secondaries)
+	primary.Secondaries = merged
+	primary.DuplicateOf = ""
+
+	_, crawled := plan.batch[loser.ID]
+	prev, stored := plan.previous[loser.ID]
+	if crawled || stored {
+		ids := []string{loser.ID}
+		for _, s := range loser.Secondaries {
+			ids = append(ids, s.ArticleID)
+		}
+		plan.demotions = append(plan.demotions, demotion{ids: ids, primary: primary.ID})
+	}
+	if stored && prev.DuplicateOf == "" && !prev.EmbargoHeld && !prev.Review.Withholds() && prev.WithdrawnAt == nil {
+		plan.demoted[loser.ID] = prev
+	}
+	loser.DuplicateOf = primary.ID
+	loser.Secondaries = nil
+
+	if _, crawled := plan.batch[primary.ID]; !crawled && changed {
+		plan.updated = append(plan.updated, primary.ID)
+	}
+}
+
+// Unstored reports whether a crawled article lost its canonical URL to the
+// primary of its story and must not be stored.
+func (plan *PrecedencePlan) Unstored(id string) bool {
+	if plan == nil {
+		return false
+	}
+	_, conflict := plan.urlOwners[id]
+	return conflict && plan.batch[id].DuplicateOf != ""
+}
+
+// movesURL reports whether a crawled article takes its canonical URL over
+// from the stored article owning it.
+func (plan *PrecedencePlan) movesURL(id string) bool {
+	owner, conflict := plan.urlOwners[id]
+	return conflict && owner != id && plan.batch[id].DuplicateOf == ""
+}
+
+// Stored returns the crawled articles to store. Those listed on the primary
+// of their story are left out; those taking a URL over are stored without it
+// until the plan is applied.
+func (plan *PrecedencePlan) Stored(articles []domain.Article) []domain.Article {
+	if plan == nil {
+		return articles
+	}
+	stored := make([]domain.Article, 0, len(articles))
+	for _, a := range articles {
+		if plan.Unstored(a.ID) {
+			continue
+		}
+		if plan.movesURL(a.ID) {
+			a.URL = ""
+		}
+		stored = append(stored, a)
+	}
+	return stored
+}
+
+// Apply links the secondaries to their primary, unlinks the new primaries,
+// moves the URLs taken over and lists the secondaries on stored primaries.
+// It then withdraws former primaries from the CMS, or republishes them as
+// secondaries when those are published, and republishes the stored primaries
+// whose secondaries changed. It runs once the crawled articles are stored.
+func (plan *PrecedencePlan) Apply(ctx context.Context) error {
+	if plan == nil {
+		return nil
+	}
+	for _, d := range plan.demotions {
+		if err := plan.p.store.Demote(ctx, d.ids, d.primary); err != nil {
+			return err
+		}
+	}
+
+	// Upserts keep a stored link the article lost
+	var promoted []string
+	for id, prev := range plan.previous {
+		if a := plan.lookup(id); a != nil && prev.DuplicateOf != "" && a.DuplicateOf == "" {
+			promoted = append(promoted, id)
+		}
+	}
+	if err := plan.p.store.Unlink(ctx, promoted); err != nil {
+		return err
+	}
+
+	for id, owner := range plan.urlOwners {
+		if plan.movesURL(id) {
+			if err := plan.p.store.MoveURL(ctx, owner, id, plan.batch[id].URL); err != nil {
+				return err
+			}
+		}
+	}
+
+	var republished []*domain.Article
+	seen := make(map[string]bool, len(plan.updated))
+	for _, id := range plan.updated {
+		primary := plan.stored[id]
+		if seen[id] || primary == nil || primary.DuplicateOf != "" {
+			continue
+		}
+		seen[id] = true
+		if err := plan.p.store.SetSecondaries(ctx, id, primary.Secondaries); err != nil {
+			return err
+		}
+		if !primary.EmbargoHeld && !primary.Review.Withholds() && primary.WithdrawnAt == nil {
+			republished = append(republished, primary)
+		}
+	}
+
+	var events []domain.Article
+	for id, prev := range plan.demoted {
+		a := plan.lookup(id)
+		if a == nil || a.DuplicateOf == "" {
+			continue
+		}
+		_, crawled := plan.batch[id]
+		switch {
+		case plan.p.suppress:
+			events = append(events, tombstone(&prev, plan.p.now()))
+		case !crawled:
+			// Crawled ones are published with their batch
+			events = append(events, *a)
+		}
+	}
+	if len(events) > 0 {
+		if err := plan.p.events.PublishBatch(ctx, events); err != nil {
+			return fmt.Errorf("failed to publish former primaries: %w", err)
+		}
+	}
+	for _, primary := range republished {
+		if err := plan.p.events.Publish(ctx, primary); err != nil {
+			return fmt.Errorf("failed to republish primary %s: %w", primary.ID, err)
+		}
+	}
+	return nil
+}
+
+// SecondaryFilter keeps secondary articles off the event stream when their
+// events are suppressed. Primaries and tombstones pass through.
+type SecondaryFilter struct {
+	events   domain.EventProducer
+	suppress bool
+}
+
+func NewSecondaryFilter(events domain.EventProducer, suppress bool) *SecondaryFilter {
+	return &SecondaryFilter{events: events, suppress: suppress}
+}
+
+func (f *SecondaryFilter) Publish(ctx context.Context, article *domain.Article) error {
+	if f.withheld(article) {
+		return nil
+	}
+	return f.events.Publish(ctx, article)
+}
+
+func (f *SecondaryFilter) PublishBatch(ctx context.Context, articles []domain.Article) error {
+	kept := make([]domain.Article, 0, len(articles))
+	for i := range articles {
+		if !f.withheld(&articles[i]) {
+			kept = append(kept, articles[i])
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return f.events.PublishBatch(ctx, kept)
+}
+
+func (f *SecondaryFilter) Close() error {
+	return f.events.Close()
+}
+
+func (f *SecondaryFilter) withheld(a *domain.Article) bool {
+	return f.suppress && a.DuplicateOf != "" && a.WithdrawnAt == nil
+}
+
+func secondaryOf(a *domain.Article) domain.SecondarySource {
+	return domain.SecondarySource{ArticleID: a.ID, Source: a.Source, Provider: a.Provider, URL: a.URL}
+}
+
+// mergeSecondaries adds more to the secondaries of a primary, replacing the
+// entries of the same article, and reports whether the list changed.
+func mergeSecondaries(primaryID string, list, more []domain.SecondarySource) ([]domain.SecondarySource, bool) {
+	merged := append([]domain.SecondarySource(nil), list...)
+	changed := false
+	for _, s := range more {
+		if s.ArticleID == primaryID {
+			continue
+		}
+		found := false
+		for i := range merged {
+			if merged[i].ArticleID == s.ArticleID {
+				found = true
+				if merged[i] != s {
+					merged[i], changed = s, true
+				}
+				break
+			}
+		}
+		if !found {
+			merged, changed = append(merged, s), true
+		}
+	}
+	return merged, changed
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryPrecedence struct {
+	memoryArticles
+}
+
+func (m *memoryPrecedence) SetSecondaries(_ context.Context, id string, secondaries []domain.SecondarySource) error {
+	a, ok := m.memoryArticles[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	a.Secondaries = secondaries
+	m.memoryArticles[id] = a
+	return nil
+}
+
+func (m *memoryPrecedence) Demote(_ context.Context, ids []string, primaryID string) error {
+	for _, id := range ids {
+		if a, ok := m.memoryArticles[id]; ok {
+			a.DuplicateOf, a.EmbargoHeld, a.Secondaries = primaryID, false, nil
+			m.memoryArticles[id] = a
+		}
+	}
+	return nil
+}
+
+func (m *memoryPrecedence) Unlink(_ context.Context, ids []string) error {
+	for _, id := range ids {
+		if a, ok := m.memoryArticles[id]; ok {
+			a.DuplicateOf = ""
+			m.memoryArticles[id] = a
+		}
+	}
+	return nil
+}
+
+func (m *memoryPrecedence) MoveURL(_ context.Context, fromID, toID, url string) error {
+	if from, ok := m.memoryArticles[fromID]; ok && from.URL == url {
+		from.URL = ""
+		m.memoryArticles[fromID] = from
+	}
+	to := m.memoryArticles[toID]
+	to.URL = url
+	m.memoryArticles[toID] = to
+	return nil
+}
+
+func newTestPrecedence(stored ...domain.Article) (*SourcePrecedence, *memoryPrecedence, *recordingProducer) {
+	store := &memoryPrecedence{memoryArticles: memoryArticles{}}
+	for _, a := range stored {
+		store.memoryArticles[a.ID] = a
+	}
+	producer := &recordingProducer{}
+	return NewSourcePrecedence(store, store, producer, map[string]int{"official": 10}, true), store, producer
+}
+
+func TestSourcePrecedenceResolve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lower ranked source becomes a secondary", func(t *testing.T) {
+		precedence, store, producer := newTestPrecedence(domain.Article{ID: "o1", Provider: "official", URL: "https://official/a"})
+		wire := &domain.Article{ID: "w1", Provider: "wire", Source: "wire", URL: "https://wire/a", DuplicateOf: "o1"}
+
+		plan, err := precedence.Resolve(ctx, nil, []*domain.Article{wire}, nil)
+		require.NoError(t, err)
+		require.NoError(t, plan.Apply(ctx))
+
+		assert.Equal(t, "o1", wire.DuplicateOf)
+		assert.False(t, plan.Unstored("w1"))
+		want := []domain.SecondarySource{{ArticleID: "w1", Source: "wire", Provider: "wire", URL: "https://wire/a"}}
+		assert.Equal(t, want, store.memoryArticles["o1"].Secondaries)
+		require.Len(t, producer.published, 1)
+		assert.Equal(t, "o1", producer.published[0].ID)
+		assert.Equal(t, want, producer.published[0].Secondaries)
+	})
+
+	t.Run("higher ranked source takes over the story", func(t *testing.T) {
+		precedence, store, producer := newTestPrecedence(domain.Article{ID: "w1", Provider: "wire", URL: "https://wire/a"})
+		official := &domain.Article{ID: "o1", Provider: "official", URL: "https://official/a", DuplicateOf: "w1"}
+
+		plan, err := precedence.Resolve(ctx, nil, []*domain.Article{official}, nil)
+		require.NoError(t, err)
+		require.NoError(t, plan.Apply(ctx))
+
+		assert.Empty(t, official.DuplicateOf)
+		require.Len(t, official.Secondaries, 1)
+		assert.Equal(t, "w1", official.Secondaries[0].ArticleID)
+		assert.Equal(t, "o1", store.memoryArticles["w1"].DuplicateOf)
+
+		// The former primary is withdrawn from the CMS
+		require.Len(t, producer.published, 1)
+		assert.Equal(t, "w1", producer.published[0].ID)
+		assert.NotNil(t, producer.published[0].WithdrawnAt)
+	})
+
+	t.Run("stored secondary outranking its primary is unlinked", func(t *testing.T) {
+		precedence, store, _ := newTestPrecedence(
+			domain.Article{ID: "w1", Provider: "wire"},
+			domain.Article{ID: "o1", Provider: "official", DuplicateOf: "w1"},
+		)
+		// Stored as a secondary before the official feed was ranked. Upserts
+		// never clear duplicate_of, so the store must.
+		official := &domain.Article{ID: "o1", Provider: "official"}
+
+		plan, err := precedence.Resolve(ctx, nil, []*domain.Article{official}, nil)
+		require.NoError(t, err)
+		require.NoError(t, plan.Apply(ctx))
+
+		assert.Empty(t, official.DuplicateOf)
+		assert.Empty(t, store.memoryArticles["o1"].DuplicateOf)
+		assert.Equal(t, "o1", store.memoryArticles["w1"].DuplicateOf)
+	})
+
+	t.Run("former primary is republished as a secondary unless suppressed", func(t *testing.T) {
+		precedence, _, producer := newTestPrecedence(domain.Article{ID: "w1", Provider: "wire", URL: "https://wire/a"})
+		precedence.suppress = false
+		official := &domain.Article{ID: "o1", Provider: "official", URL: "https://official/a", DuplicateOf: "w1"}
+
+		plan, err := precedence.Resolve(ctx, nil, []*domain.Article{official}, nil)
+		require.NoError(t, err)
+		require.NoError(t, plan.Apply(ctx))
+
+		require.Len(t, producer.published, 1)
+		assert.Equal(t, "w1", producer.published[0].ID)
+		assert.Equal(t, "o1", producer.published[0].DuplicateOf)
+		assert.Nil(t, producer.published[0].WithdrawnAt)
+	})
+
+	t.Run("ties keep the stored primary", func(t *testing.T) {
+		precedence, _, _ := newTestPrecedence(domain.Article{ID: "a1", Provider: "wire"})
+		other := &domain.Article{ID: "b1", Provider: "agency", DuplicateOf: "a1"}
+
+		_, err := precedence.Resolve(ctx, nil, []*domain.Article{other}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "a1", other.DuplicateOf)
+	})
+}
+
+func TestSourcePrecedenceURLConflicts(t *testing.T) {
+	ctx := context.Background()
+	const url = "https://example.com/story"
+
+	t.Run("lower ranked article is listed, not stored", func(t *testing.T) {
+		precedence, store, producer := newTestPrecedence(domain.Article{ID: "o1", Provider: "official", URL: url})
+		crawled := []domain.Article{{ID: "w1", Provider: "wire", URL: url}}
+
+		takeovers, plan, err := precedence.ResolveURLConflicts(ctx, crawled, map[string]string{"w1": "o1"})
+		require.NoError(t, err)
+		assert.Empty(t, store.memoryArticles["o1"].Secondaries, "nothing changes until the plan is applied")
+		require.NoError(t, plan.Apply(ctx))
+
+		assert.Empty(t, takeovers)
+		require.Len(t, store.memoryArticles["o1"].Secondaries, 1)
+		assert.Equal(t, "w1", store.memoryArticles["o1"].Secondaries[0].ArticleID)
+		assert.NotContains(t, store.memoryArticles, "w1")
+		require.Len(t, producer.published, 1)
+		assert.Equal(t, "o1", producer.published[0].ID)
+	})
+
+	t.Run("higher ranked article takes the url over", func(t *testing.T) {
+		precedence, store, producer := newTestPrecedence(domain.Article{ID: "w1", Provider: "wire", URL: url})
+		crawled := []domain.Article{{ID: "o1", Provider: "official", URL: url}}
+
+		takeovers, plan, err := precedence.ResolveURLConflicts(ctx, crawled, map[string]string{"o1": "w1"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"o1": "w1"}, takeovers)
+
+		official := &crawled[0]
+		plan, err = precedence.Resolve(ctx, plan, []*domain.Article{official}, takeovers)
+		require.NoError(t, err)
+
+		assert.Empty(t, official.DuplicateOf)
+		assert.False(t, plan.Unstored("o1"))
+		stored := plan.Stored(crawled)
+		require.Len(t, stored, 1)
+		assert.Empty(t, stored[0].URL, "stored without the url until it is moved")
+
+		require.NoError(t, plan.Apply(ctx))
+		assert.Empty(t, store.memoryArticles["w1"].URL)
+		assert.Equal(t, url, store.memoryArticles["o1"].URL)
+		assert.Equal(t, "o1", store.memoryArticles["w1"].DuplicateOf)
+		require.Len(t, producer.published, 1)
+		assert.NotNil(t, producer.published[0].WithdrawnAt)
+	})
+}
+
+func TestSecondaryFilter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	batch := []domain.Article{
+		{ID: "primary"},
+		{ID: "secondary", DuplicateOf: "primary"},
+		{ID: "withdrawn", DuplicateOf: "primary", WithdrawnAt: &now},
+	}
+
+	producer := &recordingProducer{}
+	require.NoError(t, NewSecondaryFilter(producer, true).PublishBatch(ctx, batch))
+	require.NoError(t, NewSecondaryFilter(producer, true).Publish(ctx, &batch[1]))
+	require.Len(t, producer.published, 2)
+	assert.Equal(t, "primary", producer.published[0].ID)
+	assert.Equal(t, "withdrawn", producer.published[1].ID)
+
+	producer = &recordingProducer{}
+	require.NoError(t, NewSecondaryFilter(producer, false).PublishBatch(ctx, batch))
+	assert.Len(t, producer.published, 3)
+}
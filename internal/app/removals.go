@@ -0,0 +1,155 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+)
+
+// LinkChecker requests a URL and returns the HTTP status code of the response.
+type LinkChecker interface {
+	Status(ctx context.Context, url string) (int, error)
+}
+
+// RemovalConfig configures removed-article detection.
+type RemovalConfig struct {
+	// MaxPerCrawl caps the articles withdrawn after one crawl. More missing
+	// articles are taken as a feed glitch and nothing is withdrawn.
+	MaxPerCrawl int
+}
+
+// CrawlCoverage records what a single crawl of a provider listed.
+type CrawlCoverage struct {
+	StartedAt       time.Time
+	Seen            map[string]bool
+	OldestPublished time.Time // Older articles may just have dropped off the feed
+	Failed          bool      // A batch failed, so stored state may lag behind the listing
+}
+
+func newCrawlCoverage(start time.Time) *CrawlCoverage {
+	return &CrawlCoverage{StartedAt: start, Seen: make(map[string]bool)}
+}
+
+func (c *CrawlCoverage) add(articles []domain.Article) {
+	for _, a := range articles {
+		c.Seen[a.ID] = true
+		if !a.PublishedAt.IsZero() && (c.OldestPublished.IsZero() || a.PublishedAt.Before(c.OldestPublished)) {
+			c.OldestPublished = a.PublishedAt
+		}
+	}
+}
+
+// RemovalDetector withdraws articles that disappeared from a provider's feed,
+// or whose page is gone, and publishes a tombstone event for each.
+type RemovalDetector struct {
+	store   domain.RemovalStore
+	checker LinkChecker // Optional; nil withdraws missing articles without checking their page
+	events  domain.EventProducer
+	cfg     RemovalConfig
+	now     func() time.Time
+}
+
+func NewRemovalDetector(store domain.RemovalStore, checker LinkChecker, events domain.EventProducer, cfg RemovalConfig) (*RemovalDetector, error) {
+	if cfg.MaxPerCrawl < 1 {
+		return nil, fmt.Errorf("max withdrawals per crawl must be positive, got %d", cfg.MaxPerCrawl)
+	}
+	return &RemovalDetector{store: store, checker: checker, events: events, cfg: cfg, now: time.Now}, nil
+}
+
+// Sweep withdraws the provider's stored articles that a complete crawl did not
+// list, within the publication period the feed covered. With a LinkChecker,
+// only articles whose page answers 404 or 410 are withdrawn.
+func (d *RemovalDetector) Sweep(ctx context.Context, provider string, crawl *CrawlCoverage) error {
+	if crawl.Failed || len(crawl.Seen) == 0 || crawl.OldestPublished.IsZero() {
+		return nil
+	}
+
+	unseen, err := d.store.FindUnseen(ctx, provider, crawl.StartedAt, crawl.OldestPublished)
+	if err != nil {
+		return fmt.Errorf("failed to find unseen articles: %w", err)
+	}
+	var missing []domain.Article
+	for _, a := range unseen {
+		// Listed but not stored this time, e.g. quarantined
+		if !crawl.Seen[a.ID] {
+			missing = append(missing, a)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if len(missing) > d.cfg.MaxPerCrawl {
+		slog.Warn("Too many articles missing from feed, not withdrawing any", "provider", provider, "missing", len(missing), "max", d.cfg.MaxPerCrawl)
+		metrics.RemovalSweepsSkipped.WithLabelValues(provider).Inc()
+		return nil
+	}
+
+	withdrawn := missing
+	if d.checker != nil {
+		withdrawn = d.confirmGone(ctx, provider, missing)
+	}
+	if len(withdrawn) == 0 {
+		return nil
+	}
+
+	now := d.now()
+	ids := make([]string, 0, len(withdrawn))
+	tombstones := make([]domain.Article, 0, len(withdrawn))
+	for _, a := range withdrawn {
+		slog.Info("Article withdrawn", "provider", provider, "id", a.ID, "url", a.URL)
+		ids = append(ids, a.ID)
+		tombstones = append(tombstones, tombstone(&a, now))
+	}
+
+	// Published first: if marking fails the next sweep publishes them again,
+	// which downstream deletes tolerate
+	if err := d.events.PublishBatch(ctx, tombstones); err != nil {
+		return fmt.Errorf("failed to publish tombstones: %w", err)
+	}
+	if err := d.store.MarkWithdrawn(ctx, ids, now); err != nil {
+		return fmt.Errorf("failed to mark articles withdrawn: %w", err)
+	}
+	metrics.ArticlesWithdrawn.WithLabelValues(provider).Add(float64(len(withdrawn)))
+	return nil
+}
+
+// confirmGone returns the articles whose page answers 404 or 410. Pages still
+// online, or that cannot be checked, are left for the next crawl.
+func (d *RemovalDetector) confirmGone(ctx context.Context, provider string, articles []domain.Article) []domain.Article {
+	var gone []domain.Article
+	for _, a := range articles {
+		if a.URL == "" {
+			continue
+		}
+		status, err := d.checker.Status(ctx, a.URL)
+		switch {
+		case err != nil:
+			slog.Warn("Failed to check article page", "provider", provider, "id", a.ID, "url", a.URL, "error", err)
+			metrics.RemovalChecks.WithLabelValues(provider, "error").Inc()
+		case status == http.StatusNotFound || status == http.StatusGone:
+			metrics.RemovalChecks.WithLabelValues(provider, "gone").Inc()
+			gone = append(gone, a)
+		default:
+			slog.Debug("Article missing from feed but still online", "provider", provider, "id", a.ID, "status", status)
+			metrics.RemovalChecks.WithLabelValues(provider, "online").Inc()
+		}
+	}
+	return gone
+}
+
+// tombstone is the event announcing an article's removal; it only identifies the article.
+func tombstone(a *domain.Article, at time.Time) domain.Article {
+	return domain.Article{
+		ID:          a.ID,
+		Source:      a.Source,
+		Provider:    a.Provider,
+		ExternalID:  a.ExternalID,
+		URL:         a.URL,
+		WithdrawnAt: &at,
+	}
+}
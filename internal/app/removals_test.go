@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRemovals struct {
+	articles  []domain.Article
+	withdrawn []string
+}
+
+func (m *memoryRemovals) FindUnseen(_ context.Context, provider string, seenSince, publishedFrom time.Time) ([]domain.Article, error) {
+	var out []domain.Article
+	for _, a := range m.articles {
+		if a.Provider == provider && a.LastSeenAt.Before(seenSince) && !a.PublishedAt.Before(publishedFrom) && a.WithdrawnAt == nil {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryRemovals) MarkWithdrawn(_ context.Context, ids []string, _ time.Time) error {
+	m.withdrawn = append(m.withdrawn, ids...)
+	return nil
+}
+
+type recordingProducer struct {
+	published []domain.Article
+}
+
+func (p *recordingProducer) Publish(_ context.Context, a *domain.Article) error {
+	p.published = append(p.published, *a)
+	return nil
+}
+
+func (p *recordingProducer) PublishBatch(_ context.Context, articles []domain.Article) error {
+	p.published = append(p.published, articles...)
+	return nil
+}
+
+func (p *recordingProducer) Close() error { return nil }
+
+type statusChecker map[string]int
+
+func (c statusChecker) Status(_ context.Context, url string) (int, error) {
+	return c[url], nil
+}
+
+func TestRemovalDetector_Sweep(t *testing.T) {
+	crawlStart := time.Date(2026, 7, 2, 12, 0, 0, 0, time.UTC)
+	lastCrawl := crawlStart.Add(-time.Hour)
+	day := func(d int) time.Time { return time.Date(2026, 7, d, 9, 0, 0, 0, time.UTC) }
+	stored := func() *memoryRemovals {
+		return &memoryRemovals{articles: []domain.Article{
+			{ID: "listed", Provider: "ecb", URL: "https://ecb.co.uk/1", PublishedAt: day(2), LastSeenAt: crawlStart},
+			{ID: "pulled", Provider: "ecb", URL: "https://ecb.co.uk/2", Title: "Pulled", PublishedAt: day(2), LastSeenAt: lastCrawl},
+			{ID: "moved", Provider: "ecb", URL: "https://ecb.co.uk/3", PublishedAt: day(1), LastSeenAt: lastCrawl},
+			{ID: "quarantined", Provider: "ecb", URL: "https://ecb.co.uk/4", PublishedAt: day(1), LastSeenAt: lastCrawl},
+			{ID: "aged-out", Provider: "ecb", URL: "https://ecb.co.uk/5", PublishedAt: day(1).Add(-48 * time.Hour), LastSeenAt: lastCrawl},
+			{ID: "other-feed", Provider: "bbc", URL: "https://bbc.co.uk/6", PublishedAt: day(2), LastSeenAt: lastCrawl},
+		}}
+	}
+	coverage := func() *CrawlCoverage {
+		crawl := newCrawlCoverage(crawlStart)
+		crawl.add([]domain.Article{{ID: "listed", PublishedAt: day(2)}, {ID: "quarantined", PublishedAt: day(1)}})
+		return crawl
+	}
+
+	t.Run("withdraws articles missing from the feed", func(t *testing.T) {
+		store, events := stored(), &recordingProducer{}
+		detector, err := NewRemovalDetector(store, nil, events, RemovalConfig{MaxPerCrawl: 10})
+		require.NoError(t, err)
+
+		require.NoError(t, detector.Sweep(context.Background(), "ecb", coverage()))
+
+		assert.ElementsMatch(t, []string{"pulled", "moved"}, store.withdrawn)
+		require.Len(t, events.published, 2)
+		for _, e := range events.published {
+			assert.NotNil(t, e.WithdrawnAt)
+			assert.Empty(t, e.Title, "tombstones only identify the article")
+		}
+	})
+
+	t.Run("only withdraws pages that are gone", func(t *testing.T) {
+		store, events := stored(), &recordingProducer{}
+		checker := statusChecker{"https://ecb.co.uk/2": http.StatusGone, "https://ecb.co.uk/3": http.StatusOK}
+		detector, err := NewRemovalDetector(store, checker, events, RemovalConfig{MaxPerCrawl: 10})
+		require.NoError(t, err)
+
+		require.NoError(t, detector.Sweep(context.Background(), "ecb", coverage()))
+
+		assert.Equal(t, []string{"pulled"}, store.withdrawn)
+		require.Len(t, events.published, 1)
+		assert.Equal(t, "https://ecb.co.uk/2", events.published[0].URL)
+	})
+
+	t.Run("skips a feed glitch", func(t *testing.T) {
+		store, events := stored(), &recordingProducer{}
+		detector, err := NewRemovalDetector(store, nil, events, RemovalConfig{MaxPerCrawl: 1})
+		require.NoError(t, err)
+
+		require.NoError(t, detector.Sweep(context.Background(), "ecb", coverage()))
+
+		assert.Empty(t, store.withdrawn)
+		assert.Empty(t, events.published)
+	})
+
+	t.Run("ignores crawls with failed batches", func(t *testing.T) {
+		store, events := stored(), &recordingProducer{}
+		detector, err := NewRemovalDetector(store, nil, events, RemovalConfig{MaxPerCrawl: 10})
+		require.NoError(t, err)
+		crawl := coverage()
+		crawl.Failed = true
+
+		require.NoError(t, detector.Sweep(context.Background(), "ecb", crawl))
+
+		assert.Empty(t, store.withdrawn)
+	})
+}
@@ -0,0 +1,186 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+)
+
+// ReviewRule sends the articles it matches to human review.
+type ReviewRule struct {
+	Name    string
+	Sources []string // Providers the rule applies to; empty for all
+	ArticleMatcher
+}
+
+// ReviewQueue holds the events of articles matching a review rule until a
+// reviewer approves them. Rejected articles are never published.
+type ReviewQueue struct {
+	store    domain.ReviewStore
+	articles domain.ArticleLookup
+	events   domain.EventProducer
+	rules    []ReviewRule
+	interval time.Duration // How often the queue metrics are refreshed
+	now      func() time.Time
+}
+
+func NewReviewQueue(store domain.ReviewStore, articles domain.ArticleLookup, events domain.EventProducer, rules []ReviewRule, interval time.Duration) (*ReviewQueue, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("review metrics interval must be positive, got %s", interval)
+	}
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		if r.Name == "" {
+			return nil, fmt.Errorf("review rule name is required")
+		}
+		if seen[r.Name] {
+			return nil, fmt.Errorf("duplicate review rule %q", r.Name)
+		}
+		seen[r.Name] = true
+		if r.IsZero() {
+			return nil, fmt.Errorf("review rule %s: no condition set", r.Name)
+		}
+	}
+	return &ReviewQueue{store: store, articles: articles, events: events, rules: rules, interval: interval, now: time.Now}, nil
+}
+
+// Hold sets the review of new and changed articles. Articles matching a rule
+// await review again, even if an earlier version was approved; rejected ones
+// stay rejected and pending ones stay pending.
+func (q *ReviewQueue) Hold(ctx context.Context, articles []*domain.Article) error {
+	ids := make([]string, 0, len(articles))
+	for _, a := range articles {
+		ids = append(ids, a.ID)
+	}
+	stored, err := q.store.GetReviews(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load reviews: %w", err)
+	}
+
+	now := q.now()
+	for _, a := range articles {
+		prev, reviewed := stored[a.ID]
+		if reviewed && prev.Status == domain.ReviewRejected {
+			a.Review = &prev
+			continue
+		}
+
+		rules := q.match(a, now)
+		if len(rules) == 0 {
+			if reviewed && prev.Status == domain.ReviewPending {
+				a.Review = &prev
+			}
+			continue
+		}
+
+		requested := now
+		if reviewed && prev.Status == domain.ReviewPending {
+			requested = prev.RequestedAt
+		}
+		a.Review = &domain.ArticleReview{Status: domain.ReviewPending, Rules: rules, RequestedAt: requested}
+		for _, r := range rules {
+			metrics.ArticlesHeldForReview.WithLabelValues(a.Provider, r).Inc()
+		}
+	}
+	return nil
+}
+
+// match returns the names of the rules the article matches.
+func (q *ReviewQueue) match(a *domain.Article, now time.Time) []string {
+	var names []string
+	for i := range q.rules {
+		r := &q.rules[i]
+		if len(r.Sources) > 0 && !contains(r.Sources, a.Provider) {
+			continue
+		}
+		if r.Matches(a, now) {
+			names = append(names, r.Name)
+		}
+	}
+	return names
+}
+
+// ListPending returns the articles awaiting review, oldest first.
+func (q *ReviewQueue) ListPending(ctx context.Context, limit int) ([]domain.Article, error) {
+	return q.store.FindPendingReview(ctx, limit)
+}
+
+// Approve records the approval and publishes the article, unless its embargo
+// still holds it back.
+func (q *ReviewQueue) Approve(ctx context.Context, id, reviewer, note string) (*domain.Article, error) {
+	article, err := q.decide(ctx, id, domain.ReviewApproved, reviewer, note)
+	if err != nil {
+		return nil, err
+	}
+	if !article.EmbargoHeld && article.WithdrawnAt == nil {
+		if err := q.events.Publish(ctx, article); err != nil {
+			return nil, fmt.Errorf("failed to publish approved article: %w", err)
+		}
+	}
+	return article, nil
+}
+
+// Reject records the rejection; the article is never published.
+func (q *ReviewQueue) Reject(ctx context.Context, id, reviewer, note string) (*domain.Article, error) {
+	return q.decide(ctx, id, domain.ReviewRejected, reviewer, note)
+}
+
+func (q *ReviewQueue) decide(ctx context.Context, id string, status domain.ReviewStatus, reviewer, note string) (*domain.Article, error) {
+	found, err := q.articles.GetArticles(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	article, ok := found[id]
+	if !ok {
+		return nil, fmt.Errorf("article %s: %w", id, domain.ErrNotFound)
+	}
+	if article.Review == nil || article.Review.Status != domain.ReviewPending {
+		return nil, fmt.Errorf("article %s is not awaiting review: %w", id, domain.ErrInvalidInput)
+	}
+
+	now := q.now()
+	review := *article.Review
+	review.Status = status
+	review.ReviewedAt = &now
+	review.Reviewer = strings.TrimSpace(reviewer)
+	review.Note = strings.TrimSpace(note)
+	if err := q.store.SetReview(ctx, id, review); err != nil {
+		return nil, err
+	}
+	article.Review = &review
+
+	slog.Info("Article reviewed", "id", id, "status", status, "reviewer", review.Reviewer, "waited", now.Sub(review.RequestedAt))
+	metrics.ReviewDecisions.WithLabelValues(string(status)).Inc()
+	return &article, nil
+}
+
+// Run refreshes the queue size and age metrics every interval until ctx is cancelled.
+func (q *ReviewQueue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.interval)
+	defer ticker.Stop()
+
+	for {
+		count, oldest, err := q.store.PendingReviewStats(ctx)
+		if err != nil {
+			slog.Error("Failed to read review queue stats", "error", err)
+		} else {
+			metrics.ReviewPending.Set(float64(count))
+			age := 0.0
+			if count > 0 {
+				age = q.now().Sub(oldest).Seconds()
+			}
+			metrics.ReviewOldestAge.Set(age)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
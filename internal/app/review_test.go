@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryReviews map[string]*domain.Article
+
+func (m memoryReviews) GetReviews(_ context.Context, ids []string) (map[string]domain.ArticleReview, error) {
+	out := map[string]domain.ArticleReview{}
+	for _, id := range ids {
+		if a, ok := m[id]; ok && a.Review != nil {
+			out[id] = *a.Review
+		}
+	}
+	return out, nil
+}
+
+func (m memoryReviews) SetReview(_ context.Context, id string, review domain.ArticleReview) error {
+	a, ok := m[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	a.Review = &review
+	return nil
+}
+
+func (m memoryReviews) FindPendingReview(_ context.Context, limit int) ([]domain.Article, error) {
+	var out []domain.Article
+	for _, a := range m {
+		if a.Review != nil && a.Review.Status == domain.ReviewPending {
+			out = append(out, *a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Review.RequestedAt.Before(out[j].Review.RequestedAt) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (m memoryReviews) PendingReviewStats(ctx context.Context) (int64, time.Time, error) {
+	pending, _ := m.FindPendingReview(ctx, 0)
+	if len(pending) == 0 {
+		return 0, time.Time{}, nil
+	}
+	return int64(len(pending)), pending[0].Review.RequestedAt, nil
+}
+
+func (m memoryReviews) GetArticles(_ context.Context, ids []string) (map[string]domain.Article, error) {
+	out := map[string]domain.Article{}
+	for _, id := range ids {
+		if a, ok := m[id]; ok {
+			out[id] = *a
+		}
+	}
+	return out, nil
+}
+
+func TestReviewQueueHold(t *testing.T) {
+	now := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	store := memoryReviews{
+		"rejected": {ID: "rejected", Review: &domain.ArticleReview{Status: domain.ReviewRejected, RequestedAt: earlier}},
+		"pending":  {ID: "pending", Review: &domain.ArticleReview{Status: domain.ReviewPending, RequestedAt: earlier}},
+		"approved": {ID: "approved", Review: &domain.ArticleReview{Status: domain.ReviewApproved, RequestedAt: earlier}},
+	}
+	queue, err := NewReviewQueue(store, store, &recordingProducer{}, []ReviewRule{
+		{Name: "legal", ArticleMatcher: ArticleMatcher{Body: regexp.MustCompile(`(?i)\bcourt\b`)}},
+		{Name: "ecb-only", Sources: []string{"ecb"}, ArticleMatcher: ArticleMatcher{Types: []string{"video"}}},
+	}, time.Minute)
+	require.NoError(t, err)
+	queue.now = func() time.Time { return now }
+
+	articles := []*domain.Article{
+		{ID: "clean", Provider: "ecb", Type: "text", Body: "Root hits a century"},
+		{ID: "legal", Provider: "bbc", Type: "text", Body: "The player appeared in court"},
+		{ID: "video", Provider: "ecb", Type: "video"},
+		{ID: "other-video", Provider: "bbc", Type: "video"},
+		{ID: "rejected", Provider: "ecb", Type: "video"},
+		{ID: "pending", Provider: "bbc", Type: "text", Body: "Court hearing adjourned"},
+		{ID: "approved", Provider: "bbc", Type: "text", Body: "Court clears the bowler"},
+	}
+	require.NoError(t, queue.Hold(context.Background(), articles))
+
+	assert.Nil(t, articles[0].Review)
+	require.NotNil(t, articles[1].Review)
+	assert.Equal(t, domain.ReviewPending, articles[1].Review.Status)
+	assert.Equal(t, []string{"legal"}, articles[1].Review.Rules)
+	assert.Equal(t, now, articles[1].Review.RequestedAt)
+	assert.Equal(t, []string{"ecb-only"}, articles[2].Review.Rules)
+	assert.Nil(t, articles[3].Review, "rule limited to another source")
+	assert.Equal(t, domain.ReviewRejected, articles[4].Review.Status, "rejected articles stay rejected")
+	assert.Equal(t, earlier, articles[5].Review.RequestedAt, "still pending since the first request")
+	assert.Equal(t, domain.ReviewPending, articles[6].Review.Status, "a changed article is reviewed again")
+	assert.Equal(t, now, articles[6].Review.RequestedAt)
+
+	_, err = NewReviewQueue(store, store, &recordingProducer{}, []ReviewRule{{Name: "empty"}}, time.Minute)
+	assert.Error(t, err, "a rule without conditions would hold everything")
+}
+
+func TestReviewQueueDecisions(t *testing.T) {
+	now := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	requested := now.Add(-2 * time.Hour)
+	store := memoryReviews{
+		"a":        {ID: "a", Review: &domain.ArticleReview{Status: domain.ReviewPending, RequestedAt: requested}},
+		"b":        {ID: "b", Review: &domain.ArticleReview{Status: domain.ReviewPending, RequestedAt: requested.Add(time.Hour)}},
+		"embargo":  {ID: "embargo", EmbargoHeld: true, Review: &domain.ArticleReview{Status: domain.ReviewPending, RequestedAt: now}},
+		"unlisted": {ID: "unlisted"},
+	}
+	events := &recordingProducer{}
+	queue, err := NewReviewQueue(store, store, events, nil, time.Minute)
+	require.NoError(t, err)
+	queue.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	pending, err := queue.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	assert.Equal(t, "a", pending[0].ID)
+
+	article, err := queue.Approve(ctx, "a", " alice ", "cleared by legal")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReviewApproved, article.Review.Status)
+	assert.Equal(t, "alice", article.Review.Reviewer)
+	assert.Equal(t, now, *article.Review.ReviewedAt)
+	require.Len(t, events.published, 1)
+	assert.Equal(t, "a", events.published[0].ID)
+
+	_, err = queue.Approve(ctx, "a", "bob", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "already decided")
+
+	article, err = queue.Reject(ctx, "b", "bob", "names a minor")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReviewRejected, store["b"].Review.Status)
+	assert.Equal(t, "names a minor", article.Review.Note)
+
+	_, err = queue.Approve(ctx, "embargo", "alice", "")
+	require.NoError(t, err)
+	assert.Len(t, events.published, 1, "the embargo still holds the article")
+
+	_, err = queue.Approve(ctx, "unlisted", "alice", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = queue.Approve(ctx, "missing", "alice", "")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	count, oldest, err := store.PendingReviewStats(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.True(t, oldest.IsZero())
+}
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// diffedFields are the editorial fields compared between article versions.
+// Derived fields (BodyText, WordCount...) follow their source field.
+var diffedFields = []struct {
+	name  string
+	value func(*domain.Article) string
+}{
+	{"type", func(a *domain.Article) string { return a.Type }},
+	{"title", func(a *domain.Article) string { return a.Title }},
+	{"description", func(a *domain.Article) string { return a.Description }},
+	{"summary", func(a *domain.Article) string { return a.Summary }},
+	{"body", func(a *domain.Article) string { return a.Body }},
+	{"url", func(a *domain.Article) string { return a.URL }},
+	{"image_url", func(a *domain.Article) string { return a.ImageURL }},
+	{"published_at", func(a *domain.Article) string { return formatTime(a.PublishedAt) }},
+	{"updated_at", func(a *domain.Article) string { return formatTime(a.UpdatedAt) }},
+	{"rights", func(a *domain.Article) string { return fmt.Sprint(a.Rights) }},
+	{"embargo_until", func(a *domain.Article) string {
+		if a.EmbargoUntil == nil {
+			return ""
+		}
+		return formatTime(*a.EmbargoUntil)
+	}},
+}
+
+// diffedLists are the list fields compared item by item.
+var diffedLists = []struct {
+	name  string
+	items func(*domain.Article) []string
+}{
+	{"tags", func(a *domain.Article) []string {
+		labels := make([]string, 0, len(a.Tags))
+		for _, t := range a.Tags {
+			labels = append(labels, t.Label)
+		}
+		return labels
+	}},
+	{"media", func(a *domain.Article) []string {
+		urls := make([]string, 0, len(a.Media))
+		for _, m := range a.Media {
+			urls = append(urls, mediaURLs(m)...)
+		}
+		return urls
+	}},
+	{"authors", func(a *domain.Article) []string {
+		names := make([]string, 0, len(a.Authors))
+		for _, au := range a.Authors {
+			names = append(names, au.Name)
+		}
+		return names
+	}},
+	{"canonical_tags", func(a *domain.Article) []string {
+		ids := make([]string, 0, len(a.CanonicalTags))
+		for _, t := range a.CanonicalTags {
+			ids = append(ids, t.ID)
+		}
+		return ids
+	}},
+}
+
+// DiffArticles returns the fields that differ from old to updated.
+func DiffArticles(old, updated *domain.Article) []domain.FieldChange {
+	var changes []domain.FieldChange
+	for _, f := range diffedFields {
+		if f.value(old) != f.value(updated) {
+			changes = append(changes, domain.FieldChange{Field: f.name})
+		}
+	}
+	for _, l := range diffedLists {
+		added, removed := diffItems(l.items(old), l.items(updated))
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, domain.FieldChange{Field: l.name, Added: added, Removed: removed})
+		}
+	}
+	return changes
+}
+
+func diffItems(old, updated []string) (added, removed []string) {
+	before := make(map[string]bool, len(old))
+	for _, s := range old {
+		before[s] = true
+	}
+	after := make(map[string]bool, len(updated))
+	for _, s := range updated {
+		after[s] = true
+		if !before[s] {
+			added = append(added, s)
+		}
+	}
+	for _, s := range old {
+		if !after[s] {
+			removed = append(removed, s)
+		}
+	}
+	return added, removed
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// RevisionRecorder keeps the stored version of articles about to be replaced.
+type RevisionRecorder struct {
+	articles domain.ArticleLookup
+	store    domain.RevisionStore
+	now      func() time.Time
+}
+
+func NewRevisionRecorder(articles domain.ArticleLookup, store domain.RevisionStore) *RevisionRecorder {
+	return &RevisionRecorder{articles: articles, store: store, now: time.Now}
+}
+
+// Record saves the stored version of each updated article as a revision and
+// sets the article's ChangedFields.
+func (r *RevisionRecorder) Record(ctx context.Context, updated []*domain.Article) error {
+	if len(updated) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(updated))
+	for _, a := range updated {
+		ids = append(ids, a.ID)
+	}
+	stored, err := r.articles.GetArticles(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load stored articles: %w", err)
+	}
+
+	now := r.now()
+	revisions := make([]domain.ArticleRevision, 0, len(updated))
+	for _, a := range updated {
+		old, ok := stored[a.ID]
+		if !ok {
+			continue
+		}
+		changes := DiffArticles(&old, a)
+		a.ChangedFields = make([]string, 0, len(changes))
+		for _, c := range changes {
+			a.ChangedFields = append(a.ChangedFields, c.Field)
+		}
+		revisions = append(revisions, domain.ArticleRevision{
+			ID:        a.ID + ":" + old.ContentHash,
+			ArticleID: a.ID,
+			Article:   old,
+			Changes:   changes,
+			RevisedAt: now,
+		})
+	}
+	return r.store.SaveRevisions(ctx, revisions)
+}
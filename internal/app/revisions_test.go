@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffArticles(t *testing.T) {
+	published := time.Date(2026, 7, 1, 18, 0, 0, 0, time.UTC)
+	old := &domain.Article{
+		Title: "England beat Australia", Body: "<p>Report</p>", PublishedAt: published,
+		Tags: []domain.Tag{{ID: 1, Label: "England"}, {ID: 2, Label: "Ashes"}},
+	}
+	updated := &domain.Article{
+		Title: "England beat Australia by 45 runs", Body: "<p>Report</p>", PublishedAt: published.In(time.FixedZone("BST", 3600)),
+		Tags: []domain.Tag{{ID: 1, Label: "England"}, {ID: 3, Label: "Lord's"}},
+	}
+
+	changes := DiffArticles(old, updated)
+
+	assert.Equal(t, []domain.FieldChange{
+		{Field: "title"},
+		{Field: "tags", Added: []string{"Lord's"}, Removed: []string{"Ashes"}},
+	}, changes, "the same instant in another zone is not a change")
+	assert.Empty(t, DiffArticles(old, old))
+}
+
+type memoryArticles map[string]domain.Article
+
+func (m memoryArticles) GetArticles(_ context.Context, ids []string) (map[string]domain.Article, error) {
+	out := map[string]domain.Article{}
+	for _, id := range ids {
+		if a, ok := m[id]; ok {
+			out[id] = a
+		}
+	}
+	return out, nil
+}
+
+type memoryRevisions struct {
+	saved []domain.ArticleRevision
+}
+
+func (m *memoryRevisions) SaveRevisions(_ context.Context, revs []domain.ArticleRevision) error {
+	m.saved = append(m.saved, revs...)
+	return nil
+}
+
+func (m *memoryRevisions) ListRevisions(context.Context, string, int) ([]domain.ArticleRevision, error) {
+	return m.saved, nil
+}
+
+func TestRevisionRecorder_Record(t *testing.T) {
+	stored := memoryArticles{"1": {ID: "1", Title: "Old", Summary: "Same", ContentHash: "h1"}}
+	revisions := &memoryRevisions{}
+	recorder := NewRevisionRecorder(stored, revisions)
+
+	updated := &domain.Article{ID: "1", Title: "New", Summary: "Same"}
+	require.NoError(t, recorder.Record(context.Background(), []*domain.Article{updated}))
+
+	assert.Equal(t, []string{"title"}, updated.ChangedFields)
+	require.Len(t, revisions.saved, 1)
+	assert.Equal(t, "1:h1", revisions.saved[0].ID)
+	assert.Equal(t, "Old", revisions.saved[0].Article.Title)
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// RightsOverride replaces the authorship and rights metadata a source's feed
+// supplies. Empty fields keep the feed's values.
+type RightsOverride struct {
+	Authors []domain.Author
+	Rights  domain.Rights
+	// EmbargoDelay embargoes articles this long after publication, unless the
+	// feed sets a later embargo.
+	EmbargoDelay time.Duration
+}
+
+// Apply overrides the article's metadata.
+func (o *RightsOverride) Apply(a *domain.Article) {
+	if len(o.Authors) > 0 {
+		a.Authors = o.Authors
+	}
+	if o.Rights.CopyrightHolder != "" {
+		a.Rights.CopyrightHolder = o.Rights.CopyrightHolder
+	}
+	if o.Rights.License != "" {
+		a.Rights.License = o.Rights.License
+	}
+	if o.Rights.Attribution != "" {
+		a.Rights.Attribution = o.Rights.Attribution
+	}
+	if len(o.Rights.Restrictions) > 0 {
+		a.Rights.Restrictions = o.Rights.Restrictions
+	}
+	if o.EmbargoDelay > 0 && !a.PublishedAt.IsZero() {
+		until := a.PublishedAt.Add(o.EmbargoDelay)
+		if a.EmbargoUntil == nil || a.EmbargoUntil.Before(until) {
+			a.EmbargoUntil = &until
+		}
+	}
+}
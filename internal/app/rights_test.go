@@ -0,0 +1,33 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRightsOverride_Apply(t *testing.T) {
+	published := time.Date(2026, 7, 1, 18, 0, 0, 0, time.UTC)
+	override := &RightsOverride{
+		Rights:       domain.Rights{CopyrightHolder: "ECB", Restrictions: []string{"uk-only"}},
+		EmbargoDelay: time.Hour,
+	}
+
+	a := &domain.Article{
+		PublishedAt: published,
+		Authors:     []domain.Author{{Name: "Jane Doe"}},
+		Rights:      domain.Rights{CopyrightHolder: "Feed", License: "all-rights-reserved"},
+	}
+	override.Apply(a)
+
+	assert.Equal(t, []domain.Author{{Name: "Jane Doe"}}, a.Authors, "authors not overridden are kept")
+	assert.Equal(t, domain.Rights{CopyrightHolder: "ECB", License: "all-rights-reserved", Restrictions: []string{"uk-only"}}, a.Rights)
+	assert.Equal(t, published.Add(time.Hour), *a.EmbargoUntil)
+
+	later := published.Add(24 * time.Hour)
+	a = &domain.Article{PublishedAt: published, EmbargoUntil: &later}
+	override.Apply(a)
+	assert.Equal(t, later, *a.EmbargoUntil, "a later feed embargo wins")
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -11,22 +12,219 @@ import (
 	"github.com/SportsNewsCrawler/internal/infra/metrics"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type NewsCrawlerService struct {
-	repo            domain.Repository
-	providers       []domain.Provider
-	eventProducer   domain.EventProducer
-	interval        time.Duration
-	batchSize       int
-	workerCount     int
-	jobs            chan job
-	wg              sync.WaitGroup // Service-wide WaitGroup for graceful shutdown
-	activeProviders sync.Map       // Track active provider processing
+	repo             domain.Repository
+	providers        []domain.Provider
+	eventProducer    domain.EventProducer
+	interval         time.Duration
+	batchSize        int
+	workerCount      int
+	maxCrawlDuration time.Duration           // Default crawl budget when a source has none
+	policies         map[string]SourcePolicy // Per-source settings keyed by provider name
+	validator        *Validator              // Optional; nil disables validation
+	quarantine       domain.QuarantineWriter // Destination for articles failing validation
+	enrichment       *EnrichmentChain        // Optional; nil disables enrichment
+	duplicates       *DuplicateDetector      // Optional; nil disables near-duplicate detection
+	stories          *StoryClusterer         // Optional; nil disables story clustering
+	urlCanonicalizer URLCanonicalizer        // Default for sources without their own; nil disables it
+	urls             domain.URLReader        // Finds articles already stored under a canonical URL
+	revisions        *RevisionRecorder       // Optional; nil disables revision history
+	hasher           *ContentHasher          // Default content hasher; nil uses Article.ComputeHash
+	stored           domain.ArticleLookup    // Loads stored articles to migrate hashes
+	removals         *RemovalDetector        // Optional; nil disables removed-article detection
+	variants         *VariantLinker          // Optional; nil disables translation linking
+	matchProviders   []domain.MatchProvider  // Fixtures and results feeds, crawled alongside articles
+	matchIngestion   *MatchIngestion         // Stores what the match providers crawl
+	matches          *MatchLinker            // Optional; nil disables linking articles to matches
+	review           *ReviewQueue            // Optional; nil publishes without human review
+	lifecycle        *LifecycleTracker       // Optional; nil leaves lifecycle states untracked
+	precedence       *SourcePrecedence       // Optional; nil keeps the first seen article of a story as its primary
+	embargoes        domain.EmbargoStore     // Clears the stale holds of updated articles; nil leaves them
+	duplicateLinks   domain.DuplicateLinks   // Clears the stale links of updated articles; nil leaves them
+	jobs             chan job
+	wg               sync.WaitGroup // Service-wide WaitGroup for graceful shutdown
+	activeProviders  sync.Map       // Track active provider processing
 }
 
+// SourcePolicy holds the per-source ingestion settings, keyed by provider name.
+type SourcePolicy struct {
+	// MaxCrawlDuration caps a single crawl run. Zero uses the service default.
+	MaxCrawlDuration time.Duration
+	// Enrichers is the source's enrichment chain. Nil uses the chain defaults.
+	Enrichers []EnrichmentStep
+	// URLCanonicalizer overrides the service's URL canonicalization rules.
+	URLCanonicalizer URLCanonicalizer
+	// Hasher overrides the service's content hasher.
+	Hasher *ContentHasher
+	// Rights overrides the authorship and rights metadata of the source's feed.
+	Rights *RightsOverride
+	// Language is the ISO 639-1 code of the source's articles. It overrides
+	// the feed's language and detection.
+	Language string
+	// Filter drops the source's unwanted articles before persistence.
+	Filter *ContentFilter
+}
+
+// URLCanonicalizer rewrites an article URL to its canonical form.
+type URLCanonicalizer interface {
+	Canonicalize(raw string) (string, error)
+}
+
+// Option configures optional NewsCrawlerService behaviour.
+type Option func(*NewsCrawlerService)
+
+// WithMaxCrawlDuration sets the default time budget for a single crawl run.
+// Zero disables the budget.
+func WithMaxCrawlDuration(d time.Duration) Option {
+	return func(s *NewsCrawlerService) {
+		s.maxCrawlDuration = d
+	}
+}
+
+// WithSourcePolicies sets per-source ingestion settings keyed by provider name.
+func WithSourcePolicies(policies map[string]SourcePolicy) Option {
+	return func(s *NewsCrawlerService) {
+		s.policies = policies
+	}
+}
+
+// WithValidation enables the validation stage. Articles violating any rule are
+// written to the quarantine instead of being persisted or published.
+func WithValidation(validator *Validator, quarantine domain.QuarantineWriter) Option {
+	return func(s *NewsCrawlerService) {
+		s.validator = validator
+		s.quarantine = quarantine
+	}
+}
+
+// WithEnrichment enables the enrichment stage run between validation and persistence.
+func WithEnrichment(chain *EnrichmentChain) Option {
+	return func(s *NewsCrawlerService) {
+		s.enrichment = chain
+	}
+}
+
+// WithDuplicateDetection links new and changed articles to the earlier article
+// they near-duplicate.
+func WithDuplicateDetection(detector *DuplicateDetector) Option {
+	return func(s *NewsCrawlerService) {
+		s.duplicates = detector
+	}
+}
+
+// WithStoryClustering groups new and changed articles into stories.
+func WithStoryClustering(clusterer *StoryClusterer) Option {
+	return func(s *NewsCrawlerService) {
+		s.stories = clusterer
+	}
+}
+
+// WithURLCanonicalization rewrites article URLs to their canonical form before
+// hashing and skips articles whose canonical URL is already stored under another ID.
+func WithURLCanonicalization(canonicalizer URLCanonicalizer, urls domain.URLReader) Option {
+	return func(s *NewsCrawlerService) {
+		s.urlCanonicalizer = canonicalizer
+		s.urls = urls
+	}
+}
+
+// WithRevisions keeps the stored version of updated articles as revisions.
+func WithRevisions(recorder *RevisionRecorder) Option {
+	return func(s *NewsCrawlerService) {
+		s.revisions = recorder
+	}
+}
+
+// WithContentHasher sets the default content hasher. When a stored hash was
+// computed with another field set or normalization, the stored article is
+// rehashed and compared instead, so changing the hasher does not republish
+// unchanged articles.
+func WithContentHasher(hasher *ContentHasher, stored domain.ArticleLookup) Option {
+	return func(s *NewsCrawlerService) {
+		s.hasher = hasher
+		s.stored = stored
+	}
+}
+
+// WithRemovalDetection withdraws articles that disappear from a completely
+// crawled feed after each crawl.
+func WithRemovalDetection(detector *RemovalDetector) Option {
+	return func(s *NewsCrawlerService) {
+		s.removals = detector
+	}
+}
+
+// WithVariantLinking links the language versions of the same upstream article.
+func WithVariantLinking(linker *VariantLinker) Option {
+	return func(s *NewsCrawlerService) {
+		s.variants = linker
+	}
+}
+
+// WithMatchIngestion crawls fixtures and results feeds on the article
+// providers' schedule and worker pool.
+func WithMatchIngestion(providers []domain.MatchProvider, ingestion *MatchIngestion) Option {
+	return func(s *NewsCrawlerService) {
+		s.matchProviders = providers
+		s.matchIngestion = ingestion
+	}
+}
+
+// WithMatchLinking links new and changed articles to the matches they cover.
+func WithMatchLinking(linker *MatchLinker) Option {
+	return func(s *NewsCrawlerService) {
+		s.matches = linker
+	}
+}
+
+// WithReview withholds the events of articles matching a review rule until a
+// reviewer approves them.
+func WithReview(queue *ReviewQueue) Option {
+	return func(s *NewsCrawlerService) {
+		s.review = queue
+	}
+}
+
+// WithLifecycle records new and changed articles as ingested once persisted.
+func WithLifecycle(lifecycle *LifecycleTracker) Option {
+	return func(s *NewsCrawlerService) {
+		s.lifecycle = lifecycle
+	}
+}
+
+// WithSourcePrecedence makes the article of the highest ranked source the
+// primary of a story carried by several sources.
+func WithSourcePrecedence(precedence *SourcePrecedence) Option {
+	return func(s *NewsCrawlerService) {
+		s.precedence = precedence
+	}
+}
+
+// WithEmbargoes clears the stored hold of updated articles that are no longer
+// embargoed, which upserts leave set. Otherwise the embargo scheduler would
+// publish them a second time.
+func WithEmbargoes(store domain.EmbargoStore) Option {
+	return func(s *NewsCrawlerService) {
+		s.embargoes = store
+	}
+}
+
+// WithDuplicateLinks clears the stored DuplicateOf link of updated articles
+// that no longer duplicate another, which upserts leave set. Otherwise their
+// events stay withheld as secondaries.
+func WithDuplicateLinks(store domain.DuplicateLinks) Option {
+	return func(s *NewsCrawlerService) {
+		s.duplicateLinks = store
+	}
+}
+
+// job is one scheduled crawl of a provider.
 type job struct {
-	provider domain.Provider
+	name string
+	run  func(ctx context.Context)
 }
 
 func NewNewsCrawlerService(
@@ -36,16 +234,35 @@ func NewNewsCrawlerService(
 	interval time.Duration,
 	batchSize int,
 	workerCount int,
+	opts ...Option,
 ) *NewsCrawlerService {
-	return &NewsCrawlerService{
+	s := &NewsCrawlerService{
 		repo:          repo,
 		providers:     providers,
 		eventProducer: eventProducer,
 		interval:      interval,
 		batchSize:     batchSize,
 		workerCount:   workerCount,
+		policies:      make(map[string]SourcePolicy),
 		jobs:          make(chan job, workerCount*2), // Buffer to avoid blocking providers immediately
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// policyFor returns the settings for the named source, or the zero policy.
+func (s *NewsCrawlerService) policyFor(name string) SourcePolicy {
+	return s.policies[name]
+}
+
+// crawlBudget returns the maximum duration of a single crawl for the named source.
+func (s *NewsCrawlerService) crawlBudget(name string) time.Duration {
+	if d := s.policyFor(name).MaxCrawlDuration; d > 0 {
+		return d
+	}
+	return s.maxCrawlDuration
 }
 
 func (s *NewsCrawlerService) Start(ctx context.Context) {
@@ -61,7 +278,18 @@ func (s *NewsCrawlerService) Start(ctx context.Context) {
 	for _, provider := range s.providers {
 		slog.Info("Starting provider loop", "provider", provider.GetName())
 		providersWg.Add(1)
-		go s.runProviderLoop(ctx, provider, &providersWg)
+		go s.runProviderLoop(ctx, job{name: provider.GetName(), run: func(ctx context.Context) {
+			s.processProvider(ctx, provider)
+		}}, &providersWg)
+	}
+	if s.matchIngestion != nil {
+		for _, provider := range s.matchProviders {
+			slog.Info("Starting match provider loop", "provider", provider.GetName())
+			providersWg.Add(1)
+			go s.runProviderLoop(ctx, job{name: provider.GetName(), run: func(ctx context.Context) {
+				s.processMatchProvider(ctx, provider)
+			}}, &providersWg)
+		}
 	}
 
 	// Wait for context cancellation
@@ -77,12 +305,12 @@ func (s *NewsCrawlerService) Start(ctx context.Context) {
 	slog.Info("All workers stopped")
 }
 
-func (s *NewsCrawlerService) runProviderLoop(ctx context.Context, p domain.Provider, wg *sync.WaitGroup) {
+func (s *NewsCrawlerService) runProviderLoop(ctx context.Context, j job, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	// Initial fetch
 	select {
-	case s.jobs <- job{provider: p}:
+	case s.jobs <- j:
 	case <-ctx.Done():
 		return
 	}
@@ -97,7 +325,7 @@ func (s *NewsCrawlerService) runProviderLoop(ctx context.Context, p domain.Provi
 		case <-ticker.C:
 			select {
 			// Block if channel is full to ensure backpressure
-			case s.jobs <- job{provider: p}:
+			case s.jobs <- j:
 			case <-ctx.Done():
 				return
 			}
@@ -112,7 +340,7 @@ func (s *NewsCrawlerService) worker(ctx context.Context, id int) {
 	// Process jobs until channel is closed and empty
 	for j := range s.jobs {
 		// Prevent concurrent processing of the same provider
-		name := j.provider.GetName()
+		name := j.name
 		if _, loaded := s.activeProviders.LoadOrStore(name, true); loaded {
 			slog.Warn("Skipping concurrent run", "provider", name, "worker_id", id)
 			continue
@@ -121,7 +349,7 @@ func (s *NewsCrawlerService) worker(ctx context.Context, id int) {
 		metrics.WorkerActiveCount.Inc()
 		func() {
 			defer s.activeProviders.Delete(name)
-			s.processProvider(ctx, j.provider)
+			j.run(ctx)
 		}()
 		metrics.WorkerActiveCount.Dec()
 	}
@@ -134,19 +362,86 @@ func (s *NewsCrawlerService) processProvider(ctx context.Context, provider domai
 	ctx, span := tr.Start(ctx, "processProvider")
 	defer span.End()
 
-	slog.Debug("Starting crawl for provider", "provider", provider.GetName())
-	span.SetAttributes(attribute.String("provider", provider.GetName()))
+	name := provider.GetName()
+	slog.Debug("Starting crawl for provider", "provider", name)
+	span.SetAttributes(attribute.String("provider", name))
 
 	// Define handler that processes each page of articles
+	crawl := newCrawlCoverage(time.Now())
 	handler := func(articles []domain.Article) error {
-		return s.processBatch(ctx, provider, articles)
+		// IDs are final before the crawl records them
+		s.identifyVariants(name, articles)
+		crawl.add(articles)
+		if err := s.processBatch(ctx, provider, articles); err != nil {
+			crawl.Failed = true
+			return err
+		}
+		return nil
 	}
 
-	if err := provider.Crawl(ctx, handler); err != nil {
+	switch s.runCrawl(ctx, span, name, func(crawlCtx context.Context) error {
+		return provider.Crawl(crawlCtx, handler)
+	}) {
+	case crawlError:
+		metrics.ArticlesIngested.WithLabelValues(name, "error_crawl").Inc()
+	case crawlSuccess:
+		// Only a complete crawl tells which articles left the feed
+		if s.removals != nil {
+			if err := s.removals.Sweep(ctx, name, crawl); err != nil {
+				slog.Error("Removed-article detection failed", "provider", name, "error", err)
+			}
+		}
+	}
+}
+
+// crawlOutcome is how a crawl run ended, as counted by CrawlRuns.
+type crawlOutcome string
+
+const (
+	crawlSuccess   crawlOutcome = "success"
+	crawlTimeout   crawlOutcome = "timeout"
+	crawlTruncated crawlOutcome = "truncated"
+	crawlError     crawlOutcome = "error"
+)
+
+// runCrawl runs one crawl of the provider name within its crawl budget and
+// records the run on span, in the logs and in the crawl metrics.
+func (s *NewsCrawlerService) runCrawl(ctx context.Context, span trace.Span, name string, crawl func(crawlCtx context.Context) error) crawlOutcome {
+	// Only the crawl itself is bounded by the budget. Batches keep the parent
+	// context so a page already handed to the handler is persisted in full.
+	crawlCtx := ctx
+	budget := s.crawlBudget(name)
+	if budget > 0 {
+		var cancel context.CancelFunc
+		crawlCtx, cancel = context.WithTimeout(ctx, budget)
+		defer cancel()
+		span.SetAttributes(attribute.String("crawl_budget", budget.String()))
+	}
+
+	start := time.Now()
+	err := crawl(crawlCtx)
+	metrics.CrawlDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+	outcome := crawlSuccess
+	switch {
+	case budget > 0 && ctx.Err() == nil && errors.Is(crawlCtx.Err(), context.DeadlineExceeded):
+		// Pages handled before the deadline are already persisted; the next
+		// scheduled run starts from scratch with a fresh budget.
+		span.SetAttributes(attribute.Bool("crawl_timed_out", true))
+		slog.Warn("Crawl exceeded time budget, cancelled", "provider", name, "budget", budget, "error", err)
+		outcome = crawlTimeout
+	case errors.Is(err, domain.ErrCrawlTruncated):
+		// Handled pages are persisted, but the feed was not seen in full
+		span.SetAttributes(attribute.Bool("crawl_truncated", true))
+		slog.Warn("Crawl truncated", "provider", name, "error", err)
+		outcome = crawlTruncated
+	case err != nil:
 		span.RecordError(err)
-		slog.Error("Crawl failed", "provider", provider.GetName(), "error", err)
-		metrics.ArticlesIngested.WithLabelValues(provider.GetName(), "error_crawl").Inc()
+		slog.Error("Crawl failed", "provider", name, "error", err)
+		outcome = crawlError
 	}
+	metrics.CrawlRuns.WithLabelValues(name, string(outcome)).Inc()
+	return outcome
 }
 
 func (s *NewsCrawlerService) processBatch(ctx context.Context, provider domain.Provider, articles []domain.Article) error {
@@ -163,14 +458,65 @@ func (s *NewsCrawlerService) processBatch(ctx context.Context, provider domain.P
 	}
 	articles = uniqueArticles
 
+	rights := s.policyFor(provider.GetName()).Rights
+	for i := range articles {
+		articles[i].Provider = provider.GetName()
+		articles[i].LastSeenAt = start
+		if rights != nil {
+			rights.Apply(&articles[i])
+		}
+	}
+
+	// Drop unwanted content before any work is spent on it
+	articles = s.filterBatch(provider, articles)
+
+	// Canonical URLs are hashed and keep one record per page
+	articles, conflicts, err := s.canonicalizeURLs(ctx, provider, articles)
+	if err != nil {
+		return err
+	}
+
+	// Route invalid articles to the quarantine
+	articles, err = s.validateBatch(ctx, provider, articles)
+	if err != nil {
+		return err
+	}
+
+	// Add derived data before hashing so it is persisted with the article
+	if s.enrichment != nil && len(articles) > 0 {
+		articles, err = s.enrichment.Run(ctx, provider.GetName(), s.policyFor(provider.GetName()).Enrichers, articles)
+		if err != nil {
+			return fmt.Errorf("enrichment failed: %w", err)
+		}
+	}
+
+	// Only articles that passed validation can take over a story
+	var takeovers map[string]string
+	var plan *PrecedencePlan
+	if len(conflicts) > 0 {
+		articles, takeovers, plan, err = s.resolveURLConflicts(ctx, articles, conflicts)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(articles) == 0 {
+		// Nothing to store, the articles are only listed on stored primaries
+		if err := plan.Apply(ctx); err != nil {
+			return fmt.Errorf("failed to apply source precedence: %w", err)
+		}
 		return nil
 	}
 
 	// 1. Calculate Hashes
+	hasher := s.hasherFor(provider.GetName())
 	var ids []string
 	for i := range articles {
-		articles[i].ContentHash = articles[i].ComputeHash()
+		if hasher != nil {
+			articles[i].ContentHash = hasher.Hash(&articles[i])
+		} else {
+			articles[i].ContentHash = articles[i].ComputeHash()
+		}
 		ids = append(ids, articles[i].ID)
 	}
 
@@ -182,17 +528,28 @@ func (s *NewsCrawlerService) processBatch(ctx context.Context, provider domain.P
 		return fmt.Errorf("failed to fetch hashes: %w", err)
 	}
 
+	// Hashes stored by another hasher version are compared on the stored content
+	rehashed, err := s.rehashStored(ctx, provider, hasher, articles, existingHashes)
+	if err != nil {
+		return err
+	}
+
 	// 3. Identify Changed Articles
-	var changedArticles []domain.Article
+	var changed, updated []*domain.Article
 	skippedCount := 0
-	for _, article := range articles {
+	for i := range articles {
+		article := &articles[i]
 		oldHash, exists := existingHashes[article.ID]
 		if !exists {
 			slog.Info("Article New", "provider", provider.GetName(), "id", article.ID)
-			changedArticles = append(changedArticles, article)
-		} else if oldHash != article.ContentHash {
+			changed = append(changed, article)
+		} else if (oldHash != article.ContentHash && !rehashed[article.ID]) || takeovers[article.ID] != "" {
 			slog.Info("Article Changed", "provider", provider.GetName(), "id", article.ID)
-			changedArticles = append(changedArticles, article)
+			changed = append(changed, article)
+			// Withdrawn articles listed again have no hash and no version to keep
+			if oldHash != "" {
+				updated = append(updated, article)
+			}
 		} else {
 			skippedCount++
 		}
@@ -202,6 +559,84 @@ func (s *NewsCrawlerService) processBatch(ctx context.Context, provider domain.P
 		metrics.ArticlesDuplicatesSkipped.WithLabelValues(provider.GetName()).Add(float64(skippedCount))
 	}
 
+	// Link near duplicates (e.g. the same wire story from another outlet) to their canonical article
+	if s.duplicates != nil && len(changed) > 0 {
+		if err := s.duplicates.Detect(ctx, changed); err != nil {
+			return fmt.Errorf("near-duplicate detection failed: %w", err)
+		}
+	}
+
+	// Keep one primary article per story, from the highest ranked source
+	if s.precedence != nil && len(changed) > 0 {
+		plan, err = s.precedence.Resolve(ctx, plan, changed, takeovers)
+		if err != nil {
+			return fmt.Errorf("source precedence failed: %w", err)
+		}
+	}
+
+	// Group articles about the same event into stories
+	if s.stories != nil && len(changed) > 0 {
+		if err := s.stories.Assign(ctx, changed); err != nil {
+			return fmt.Errorf("story clustering failed: %w", err)
+		}
+	}
+
+	// List the other language versions on the events
+	if s.variants != nil && len(changed) > 0 {
+		if err := s.variants.Link(ctx, changed); err != nil {
+			return fmt.Errorf("variant linking failed: %w", err)
+		}
+	}
+
+	// Link match reports and previews to the fixtures they cover
+	if s.matches != nil && len(changed) > 0 {
+		if err := s.matches.Link(ctx, changed); err != nil {
+			return fmt.Errorf("match linking failed: %w", err)
+		}
+	}
+
+	// Keep the versions about to be overwritten
+	if s.revisions != nil && len(updated) > 0 {
+		if err := s.revisions.Record(ctx, updated); err != nil {
+			return fmt.Errorf("failed to record revisions: %w", err)
+		}
+	}
+
+	// Sensitive content waits for a reviewer
+	if s.review != nil && len(changed) > 0 {
+		if err := s.review.Hold(ctx, changed); err != nil {
+			return fmt.Errorf("review check failed: %w", err)
+		}
+	}
+
+	var changedArticles []domain.Article
+	for _, article := range changed {
+		if article.DuplicateOf != "" {
+			// Listed on its primary instead of stored
+			if plan.Unstored(article.ID) {
+				continue
+			}
+			slog.Info("Article secondary", "provider", provider.GetName(), "id", article.ID, "duplicate_of", article.DuplicateOf)
+			metrics.ArticlesNearDuplicate.WithLabelValues(provider.GetName()).Inc()
+			if s.duplicates == nil || s.duplicates.SuppressEvents() {
+				continue
+			}
+		}
+		// Persisted now, published by the embargo scheduler
+		if article.EmbargoUntil != nil && article.EmbargoUntil.After(start) {
+			slog.Info("Article embargoed, event withheld", "provider", provider.GetName(), "id", article.ID, "embargo_until", article.EmbargoUntil)
+			metrics.ArticlesEmbargoed.WithLabelValues(provider.GetName()).Inc()
+			article.EmbargoHeld = true
+			continue
+		}
+		// Persisted now, published once approved
+		if article.Review.Withholds() {
+			slog.Info("Article withheld for review", "provider", provider.GetName(), "id", article.ID, "status", article.Review.Status, "rules", article.Review.Rules)
+			continue
+		}
+		changedArticles = append(changedArticles, *article)
+	}
+
 	metrics.ProviderFetchDuration.WithLabelValues(provider.GetName()).Observe(time.Since(start).Seconds())
 	metrics.ArticlesIngested.WithLabelValues(provider.GetName(), "success").Add(float64(len(articles)))
 	// Initialize published metrics to ensure they appear in Grafana even if 0
@@ -216,9 +651,47 @@ func (s *NewsCrawlerService) processBatch(ctx context.Context, provider domain.P
 	}
 
 	// 4. Bulk Upsert
-	if err := s.repo.BulkUpsert(ctx, articles); err != nil {
+	persistedAt := time.Now()
+	if err := s.repo.BulkUpsert(ctx, plan.Stored(articles)); err != nil {
 		return fmt.Errorf("bulk upsert failed: %w", err)
 	}
+	if s.embargoes != nil {
+		var released []string
+		for _, article := range changed {
+			if _, exists := existingHashes[article.ID]; exists && !article.EmbargoHeld {
+				released = append(released, article.ID)
+			}
+		}
+		// Left held, the scheduler publishes the stored version instead
+		if err := s.embargoes.MarkReleased(ctx, released); err != nil {
+			return fmt.Errorf("failed to clear embargo holds: %w", err)
+		}
+	}
+	if s.duplicateLinks != nil {
+		var unlinked []string
+		for _, article := range changed {
+			if _, exists := existingHashes[article.ID]; exists && article.DuplicateOf == "" && !plan.Unstored(article.ID) {
+				unlinked = append(unlinked, article.ID)
+			}
+		}
+		if err := s.duplicateLinks.Unlink(ctx, unlinked); err != nil {
+			return fmt.Errorf("failed to clear duplicate links: %w", err)
+		}
+	}
+	// Only once the batch is stored, so a failed upsert leaves no side effects
+	if err := plan.Apply(ctx); err != nil {
+		return fmt.Errorf("failed to apply source precedence: %w", err)
+	}
+	if s.lifecycle != nil && len(changed) > 0 {
+		ids := make([]string, len(changed))
+		for i, article := range changed {
+			ids[i] = article.ID
+		}
+		// The articles are stored; failing here must not keep them from being published
+		if err := s.lifecycle.Record(ctx, ids, domain.LifecycleIngested, persistedAt, ""); err != nil {
+			slog.Error("Failed to record ingested articles", "provider", provider.GetName(), "count", len(ids), "error", err)
+		}
+	}
 
 	// 5. Publish Changed
 	if len(changedArticles) > 0 {
@@ -240,4 +713,202 @@ func (s *NewsCrawlerService) processBatch(ctx context.Context, provider domain.P
 	return nil
 }
 
+// identifyVariants applies the source's declared language and assigns the
+// articles' variant groups, which suffixes the IDs of translations.
+func (s *NewsCrawlerService) identifyVariants(name string, articles []domain.Article) {
+	if lang := s.policyFor(name).Language; lang != "" {
+		for i := range articles {
+			articles[i].Language = lang
+		}
+	}
+	if s.variants != nil {
+		s.variants.Identify(articles)
+	}
+}
+
+// hasherFor returns the content hasher for the named source, nil for the legacy hash.
+func (s *NewsCrawlerService) hasherFor(name string) *ContentHasher {
+	if h := s.policyFor(name).Hasher; h != nil {
+		return h
+	}
+	return s.hasher
+}
+
+// rehashStored returns the IDs of articles whose stored hash comes from another
+// hasher version but whose stored content hashes the same with the current
+// one. They are unchanged: the upsert only migrates their hash.
+func (s *NewsCrawlerService) rehashStored(ctx context.Context, provider domain.Provider, hasher *ContentHasher, articles []domain.Article, existing map[string]string) (map[string]bool, error) {
+	if hasher == nil || s.stored == nil {
+		return nil, nil
+	}
+
+	var ids []string
+	for _, a := range articles {
+		if old, ok := existing[a.ID]; ok && old != "" && old != a.ContentHash && hashVersion(old) != hasher.Version() {
+			ids = append(ids, a.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
 
+	stored, err := s.stored.GetArticles(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load articles to migrate hashes: %w", err)
+	}
+
+	unchanged := make(map[string]bool)
+	for _, a := range articles {
+		old, ok := stored[a.ID]
+		if ok && hasher.Hash(&old) == a.ContentHash {
+			unchanged[a.ID] = true
+		}
+	}
+	if len(unchanged) > 0 {
+		slog.Info("Migrated content hashes", "provider", provider.GetName(), "count", len(unchanged), "version", hasher.Version())
+		metrics.HashesMigrated.WithLabelValues(provider.GetName()).Add(float64(len(unchanged)))
+	}
+	return unchanged, nil
+}
+
+// filterBatch returns the articles the source's filter rules keep.
+func (s *NewsCrawlerService) filterBatch(provider domain.Provider, articles []domain.Article) []domain.Article {
+	filter := s.policyFor(provider.GetName()).Filter
+	if filter == nil {
+		return articles
+	}
+
+	kept := make([]domain.Article, 0, len(articles))
+	for _, a := range articles {
+		if rule := filter.Check(&a); rule != "" {
+			slog.Debug("Article filtered out", "provider", provider.GetName(), "id", a.ID, "rule", rule)
+			metrics.ArticlesFiltered.WithLabelValues(provider.GetName(), rule).Inc()
+			continue
+		}
+		kept = append(kept, a)
+	}
+	return kept
+}
+
+// validateBatch returns the articles that pass validation and quarantines the rest.
+func (s *NewsCrawlerService) validateBatch(ctx context.Context, provider domain.Provider, articles []domain.Article) ([]domain.Article, error) {
+	if s.validator == nil {
+		return articles, nil
+	}
+
+	valid := make([]domain.Article, 0, len(articles))
+	var rejected []domain.QuarantinedArticle
+	now := time.Now()
+	for _, a := range articles {
+		violations := s.validator.Validate(&a)
+		if len(violations) == 0 {
+			valid = append(valid, a)
+			continue
+		}
+
+		for _, v := range violations {
+			metrics.ArticlesQuarantined.WithLabelValues(provider.GetName(), v.Rule).Inc()
+		}
+		slog.Warn("Article failed validation", "provider", provider.GetName(), "id", a.ID, "violations", violations)
+		rejected = append(rejected, domain.QuarantinedArticle{
+			ID:            a.ID,
+			Provider:      provider.GetName(),
+			Article:       a,
+			Violations:    violations,
+			QuarantinedAt: now,
+		})
+	}
+
+	if len(rejected) > 0 && s.quarantine != nil {
+		if err := s.quarantine.Quarantine(ctx, rejected); err != nil {
+			return nil, fmt.Errorf("failed to quarantine articles: %w", err)
+		}
+	}
+
+	return valid, nil
+}
+
+// canonicalizeURLs rewrites article URLs to their canonical form and drops
+// articles whose page is stored, or earlier in the batch, under another ID.
+// With source precedence, articles whose page is stored under another ID are
+// kept for resolveURLConflicts and returned with the owner of the page.
+func (s *NewsCrawlerService) canonicalizeURLs(ctx context.Context, provider domain.Provider, articles []domain.Article) ([]domain.Article, map[string]string, error) {
+	canonicalizer := s.policyFor(provider.GetName()).URLCanonicalizer
+	if canonicalizer == nil {
+		canonicalizer = s.urlCanonicalizer
+	}
+	if canonicalizer == nil {
+		return articles, nil, nil
+	}
+
+	var urls []string
+	for i := range articles {
+		a := &articles[i]
+		if a.URL == "" {
+			continue
+		}
+		canonical, err := canonicalizer.Canonicalize(a.URL)
+		if err != nil {
+			// Left as is for validation to report
+			slog.Debug("Cannot canonicalize url", "provider", provider.GetName(), "id", a.ID, "error", err)
+			continue
+		}
+		if canonical != a.URL {
+			a.OriginalURL = a.URL
+			a.URL = canonical
+		}
+		urls = append(urls, a.URL)
+	}
+	if s.urls == nil || len(urls) == 0 {
+		return articles, nil, nil
+	}
+
+	owners, err := s.urls.GetIDsByURL(ctx, urls)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to look up canonical urls: %w", err)
+	}
+
+	var conflicts map[string]string
+	claimed := make(map[string]string, len(urls))
+	kept := make([]domain.Article, 0, len(articles))
+	for _, a := range articles {
+		if a.URL != "" {
+			if id, ok := claimed[a.URL]; ok {
+				slog.Info("Article url already in the batch under another id", "provider", provider.GetName(), "id", a.ID, "url", a.URL, "batch_id", id)
+				metrics.ArticlesURLConflicts.WithLabelValues(provider.GetName()).Inc()
+				continue
+			}
+			if owner, ok := owners[a.URL]; ok && owner != a.ID {
+				slog.Info("Article url already stored under another id", "provider", provider.GetName(), "id", a.ID, "url", a.URL, "stored_id", owner)
+				metrics.ArticlesURLConflicts.WithLabelValues(provider.GetName()).Inc()
+				if s.precedence == nil {
+					continue
+				}
+				if conflicts == nil {
+					conflicts = make(map[string]string)
+				}
+				conflicts[a.ID] = owner
+			}
+			claimed[a.URL] = a.ID
+		}
+		kept = append(kept, a)
+	}
+	return kept, conflicts, nil
+}
+
+// resolveURLConflicts keeps the articles that take over the story of the
+// stored article owning their page, returned with that owner. The others are
+// listed on the story's primary once the returned plan is applied.
+func (s *NewsCrawlerService) resolveURLConflicts(ctx context.Context, articles []domain.Article, conflicts map[string]string) ([]domain.Article, map[string]string, *PrecedencePlan, error) {
+	takeovers, plan, err := s.precedence.ResolveURLConflicts(ctx, articles, conflicts)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to resolve url conflicts: %w", err)
+	}
+	kept := make([]domain.Article, 0, len(articles))
+	for _, a := range articles {
+		if _, conflict := conflicts[a.ID]; !conflict || takeovers[a.ID] != "" {
+			kept = append(kept, a)
+		}
+	}
+	return kept, takeovers, plan, nil
+}
@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -93,4 +95,350 @@ func TestNewsCrawlerService_Deduplication(t *testing.T) {
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 	producer.AssertExpectations(t)
-}
\ No newline at end of file
+}
+
+// hangingProvider delivers one page and then blocks until its context is done,
+// simulating an upstream API that stalls between pages.
+type hangingProvider struct{}
+
+func (p *hangingProvider) GetName() string { return "hanging-provider" }
+
+func (p *hangingProvider) Crawl(ctx context.Context, handler func([]domain.Article) error) error {
+	if err := handler([]domain.Article{{ID: "h1", Title: "Page 1", Source: "test", URL: "u1"}}); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestNewsCrawlerService_CrawlTimeBudget(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := &hangingProvider{}
+
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1,
+		WithMaxCrawlDuration(time.Hour),
+		WithSourcePolicies(map[string]SourcePolicy{
+			"hanging-provider": {MaxCrawlDuration: 50 * time.Millisecond},
+		}),
+	)
+
+	repo.On("GetContentHashes", mock.Anything, []string{"h1"}).Return(map[string]string{}, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.Anything).Return(nil)
+	producer.On("PublishBatch", mock.Anything, mock.Anything).Return(nil)
+
+	done := make(chan struct{})
+	go func() {
+		service.processProvider(context.Background(), provider)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("crawl was not cancelled after its time budget")
+	}
+
+	// The page delivered before the deadline is kept
+	repo.AssertCalled(t, "BulkUpsert", mock.Anything, mock.Anything)
+	producer.AssertExpectations(t)
+	assert.Equal(t, 50*time.Millisecond, service.crawlBudget("hanging-provider"))
+	assert.Equal(t, time.Hour, service.crawlBudget("other-provider"))
+}
+
+type MockQuarantine struct {
+	mock.Mock
+}
+
+func (m *MockQuarantine) Quarantine(ctx context.Context, items []domain.QuarantinedArticle) error {
+	args := m.Called(ctx, items)
+	return args.Error(0)
+}
+
+func TestNewsCrawlerService_ValidationQuarantine(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+	quarantine := new(MockQuarantine)
+
+	validator, err := NewValidator(ValidationConfig{RequiredFields: []string{"title", "published_at"}})
+	assert.NoError(t, err)
+
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1,
+		WithValidation(validator, quarantine),
+	)
+
+	good := domain.Article{ID: "1", Title: "Good", URL: "https://example.com/1", PublishedAt: time.Now()}
+	bad := domain.Article{ID: "2", Title: "", URL: "https://example.com/2"}
+
+	quarantine.On("Quarantine", mock.Anything, mock.MatchedBy(func(items []domain.QuarantinedArticle) bool {
+		return len(items) == 1 && items[0].ID == "2" && items[0].Provider == "test-provider" &&
+			items[0].Violations[0].Rule == "required_fields"
+	})).Return(nil)
+	repo.On("GetContentHashes", mock.Anything, []string{"1"}).Return(map[string]string{}, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "1"
+	})).Return(nil)
+	producer.On("PublishBatch", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "1"
+	})).Return(nil)
+
+	err = service.processBatch(context.Background(), provider, []domain.Article{good, bad})
+
+	assert.NoError(t, err)
+	quarantine.AssertExpectations(t)
+	repo.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+type MockURLReader struct {
+	mock.Mock
+}
+
+func (m *MockURLReader) GetIDsByURL(ctx context.Context, urls []string) (map[string]string, error) {
+	args := m.Called(ctx, urls)
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+// trimQuery is a minimal URLCanonicalizer dropping the query string.
+type trimQuery struct{}
+
+func (trimQuery) Canonicalize(raw string) (string, error) {
+	return strings.SplitN(raw, "?", 2)[0], nil
+}
+
+func TestNewsCrawlerService_URLCanonicalization(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+	urls := new(MockURLReader)
+
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1,
+		WithURLCanonicalization(trimQuery{}, urls),
+	)
+
+	fresh := domain.Article{ID: "1", Title: "Fresh", URL: "https://example.com/a?utm_source=x"}
+	sameInBatch := domain.Article{ID: "2", Title: "Same page", URL: "https://example.com/a"}
+	storedElsewhere := domain.Article{ID: "3", Title: "Wire copy", URL: "https://example.com/b?ref=feed"}
+
+	urls.On("GetIDsByURL", mock.Anything, []string{"https://example.com/a", "https://example.com/a", "https://example.com/b"}).
+		Return(map[string]string{"https://example.com/b": "other-source-9"}, nil)
+	repo.On("GetContentHashes", mock.Anything, []string{"1"}).Return(map[string]string{}, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "1" &&
+			articles[0].URL == "https://example.com/a" && articles[0].OriginalURL == "https://example.com/a?utm_source=x"
+	})).Return(nil)
+	producer.On("PublishBatch", mock.Anything, mock.Anything).Return(nil)
+
+	err := service.processBatch(context.Background(), provider, []domain.Article{fresh, sameInBatch, storedElsewhere})
+
+	assert.NoError(t, err)
+	urls.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestNewsCrawlerService_URLConflictAfterValidation(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+	urls := new(MockURLReader)
+	quarantine := new(MockQuarantine)
+
+	validator, err := NewValidator(ValidationConfig{RequiredFields: []string{"title"}})
+	assert.NoError(t, err)
+	precedence, store, events := newTestPrecedence(domain.Article{ID: "w9", Provider: "wire", URL: "https://example.com/a"})
+
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1,
+		WithURLCanonicalization(trimQuery{}, urls),
+		WithValidation(validator, quarantine),
+		WithSourcePrecedence(precedence),
+	)
+
+	invalid := domain.Article{ID: "1", URL: "https://example.com/a?utm_source=x"}
+
+	urls.On("GetIDsByURL", mock.Anything, []string{"https://example.com/a"}).
+		Return(map[string]string{"https://example.com/a": "w9"}, nil)
+	quarantine.On("Quarantine", mock.Anything, mock.Anything).Return(nil)
+
+	err = service.processBatch(context.Background(), provider, []domain.Article{invalid})
+
+	assert.NoError(t, err)
+	quarantine.AssertExpectations(t)
+	assert.Empty(t, store.memoryArticles["w9"].Secondaries, "quarantined articles are not listed on the primary")
+	assert.Empty(t, events.published)
+}
+
+func TestNewsCrawlerService_HashMigration(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+
+	hasher, err := NewContentHasher([]string{"title", "body"})
+	assert.NoError(t, err)
+
+	// Both were stored with the legacy hash; only the second really changed
+	unchanged := domain.Article{ID: "1", Title: "Same", Body: "<p>Same</p>", URL: "u1"}
+	edited := domain.Article{ID: "2", Title: "Edited", Body: "<p>New</p>", URL: "u2"}
+	previous := domain.Article{ID: "2", Title: "Edited", Body: "<p>Old</p>", URL: "u2"}
+	stored := memoryArticles{"1": unchanged, "2": previous}
+	legacy := map[string]string{"1": unchanged.ComputeHash(), "2": previous.ComputeHash()}
+
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1,
+		WithContentHasher(hasher, stored),
+	)
+
+	repo.On("GetContentHashes", mock.Anything, []string{"1", "2"}).Return(legacy, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 2 && hashVersion(articles[0].ContentHash) == hasher.Version()
+	})).Return(nil)
+	producer.On("PublishBatch", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "2"
+	})).Return(nil)
+
+	err = service.processBatch(context.Background(), provider, []domain.Article{unchanged, edited})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestNewsCrawlerService_Embargo(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1)
+
+	until := time.Now().Add(time.Hour)
+	embargoed := domain.Article{ID: "1", Title: "Squad announcement", URL: "u1", EmbargoUntil: &until}
+	open := domain.Article{ID: "2", Title: "Match report", URL: "u2"}
+
+	repo.On("GetContentHashes", mock.Anything, []string{"1", "2"}).Return(map[string]string{}, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 2 && articles[0].EmbargoHeld && !articles[1].EmbargoHeld
+	})).Return(nil)
+	producer.On("PublishBatch", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "2"
+	})).Return(nil)
+
+	err := service.processBatch(context.Background(), provider, []domain.Article{embargoed, open})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestNewsCrawlerService_EmbargoLiftedOnUpdate(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+
+	past := time.Now().Add(-time.Minute)
+	store := memoryEmbargoes{
+		"1": {ID: "1", Title: "Squad announcement", URL: "u1", EmbargoUntil: &past, EmbargoHeld: true},
+	}
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1, WithEmbargoes(store))
+
+	// Re-crawled after its embargo ended: published now, so the scheduler must not publish it again
+	updated := domain.Article{ID: "1", Title: "Squad announced", URL: "u1", EmbargoUntil: &past}
+	repo.On("GetContentHashes", mock.Anything, []string{"1"}).Return(map[string]string{"1": "old_hash"}, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		// Like the store, keep fields the upsert leaves empty
+		for _, a := range args.Get(1).([]domain.Article) {
+			a.EmbargoHeld = a.EmbargoHeld || store[a.ID].EmbargoHeld
+			store[a.ID] = &a
+		}
+	}).Return(nil)
+	producer.On("PublishBatch", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "1"
+	})).Return(nil)
+
+	err := service.processBatch(context.Background(), provider, []domain.Article{updated})
+	assert.NoError(t, err)
+	producer.AssertExpectations(t)
+
+	events := &recordingProducer{}
+	scheduler, err := NewEmbargoScheduler(store, store, events, time.Minute)
+	assert.NoError(t, err)
+	released, err := scheduler.ReleaseDue(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, released)
+	assert.Empty(t, events.published)
+}
+
+func TestNewsCrawlerService_DuplicateLinkCleared(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+
+	index := &memoryFingerprintIndex{saved: map[string]domain.Fingerprint{}}
+	detector, err := NewDuplicateDetector(index, DuplicateConfig{MaxDistance: 3, Window: 48 * time.Hour, MinWords: 1, SuppressEvents: true})
+	assert.NoError(t, err)
+	links := &memoryPrecedence{memoryArticles: memoryArticles{
+		"2": {ID: "2", Title: "Wire copy", URL: "u2", DuplicateOf: "1"},
+	}}
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1,
+		WithDuplicateDetection(detector),
+		WithDuplicateLinks(links),
+	)
+
+	// Rewritten, it no longer matches its former primary
+	updated := domain.Article{ID: "2", Title: "Exclusive interview", URL: "u2"}
+	repo.On("GetContentHashes", mock.Anything, []string{"2"}).Return(map[string]string{"2": "old_hash"}, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.Anything).Return(nil)
+	producer.On("PublishBatch", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "2" && articles[0].DuplicateOf == ""
+	})).Return(nil)
+
+	err = service.processBatch(context.Background(), provider, []domain.Article{updated})
+	assert.NoError(t, err)
+	producer.AssertExpectations(t)
+	assert.Empty(t, links.memoryArticles["2"].DuplicateOf)
+}
+
+func TestNewsCrawlerService_PrecedenceAfterUpsert(t *testing.T) {
+	repo := new(MockRepo)
+	producer := new(MockProducer)
+	provider := new(MockProvider)
+	urls := new(MockURLReader)
+
+	const url = "https://example.com/a"
+	precedence, store, events := newTestPrecedence(domain.Article{ID: "w9", Provider: "wire", URL: url})
+	precedence.ranks = map[string]int{provider.GetName(): 10}
+	service := NewNewsCrawlerService(repo, []domain.Provider{provider}, producer, time.Minute, 10, 1,
+		WithURLCanonicalization(trimQuery{}, urls),
+		WithSourcePrecedence(precedence),
+	)
+
+	// Outranks the stored wire copy of its page
+	official := domain.Article{ID: "o1", Title: "Official", URL: url + "?utm_source=x"}
+	urls.On("GetIDsByURL", mock.Anything, []string{url}).Return(map[string]string{url: "w9"}, nil)
+	repo.On("GetContentHashes", mock.Anything, []string{"o1"}).Return(map[string]string{}, nil)
+	repo.On("BulkUpsert", mock.Anything, mock.Anything).Return(errors.New("write failed")).Once()
+
+	err := service.processBatch(context.Background(), provider, []domain.Article{official})
+
+	assert.Error(t, err)
+	assert.Empty(t, store.memoryArticles["w9"].DuplicateOf, "nothing is demoted")
+	assert.Equal(t, url, store.memoryArticles["w9"].URL)
+	assert.Empty(t, events.published)
+	producer.AssertNotCalled(t, "PublishBatch", mock.Anything, mock.Anything)
+
+	// Stored without the url, which moves once the old owner released it
+	repo.On("BulkUpsert", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "o1" && articles[0].URL == ""
+	})).Return(nil).Once()
+	producer.On("PublishBatch", mock.Anything, mock.MatchedBy(func(articles []domain.Article) bool {
+		return len(articles) == 1 && articles[0].ID == "o1" && articles[0].URL == url
+	})).Return(nil)
+
+	err = service.processBatch(context.Background(), provider, []domain.Article{official})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	producer.AssertExpectations(t)
+	assert.Equal(t, "o1", store.memoryArticles["w9"].DuplicateOf)
+	assert.Empty(t, store.memoryArticles["w9"].URL)
+	assert.Equal(t, url, store.memoryArticles["o1"].URL)
+	assert.Len(t, events.published, 1, "the former primary is withdrawn")
+}
@@ -0,0 +1,281 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+// Weights of the story similarity components; they add up to 1.
+const (
+	storyTextWeight   = 0.5
+	storyEntityWeight = 0.3
+	storyTagWeight    = 0.2
+	storyMaxTerms     = 100 // Terms kept per story
+)
+
+// StoryConfig configures story clustering.
+type StoryConfig struct {
+	Window    time.Duration // Max gap between an article and a story's publication period
+	Threshold float64       // Min similarity, in (0, 1], for an article to join a story
+}
+
+// StoryClusterer incrementally groups articles about the same event into stories.
+type StoryClusterer struct {
+	store  domain.StoryStore
+	events domain.StoryEventPublisher
+	cfg    StoryConfig
+	now    func() time.Time
+}
+
+func NewStoryClusterer(store domain.StoryStore, events domain.StoryEventPublisher, cfg StoryConfig) (*StoryClusterer, error) {
+	if cfg.Threshold <= 0 || cfg.Threshold > 1 {
+		return nil, fmt.Errorf("story threshold must be in (0, 1], got %v", cfg.Threshold)
+	}
+	if cfg.Window <= 0 {
+		return nil, fmt.Errorf("story window must be positive, got %s", cfg.Window)
+	}
+	return &StoryClusterer{store: store, events: events, cfg: cfg, now: time.Now}, nil
+}
+
+// storyFeatures are the parts of an article stories are compared on.
+type storyFeatures struct {
+	terms     map[string]float64
+	entities  []string
+	tags      []string
+	published time.Time
+}
+
+// Assign sets StoryID on each article, joining the most similar story or
+// starting a new one, and publishes a story-updated event for every story that
+// grew to two or more articles.
+func (c *StoryClusterer) Assign(ctx context.Context, articles []*domain.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	now := c.now()
+	features := make([]storyFeatures, len(articles))
+	query := domain.StoryQuery{}
+	for i, a := range articles {
+		f := c.features(a, now)
+		features[i] = f
+		query.ArticleIDs = append(query.ArticleIDs, a.ID)
+		query.EntityIDs = append(query.EntityIDs, f.entities...)
+		query.TagIDs = append(query.TagIDs, f.tags...)
+		if query.From.IsZero() || f.published.Before(query.From) {
+			query.From = f.published
+		}
+		if f.published.After(query.To) {
+			query.To = f.published
+		}
+	}
+	query.From = query.From.Add(-c.cfg.Window)
+	query.To = query.To.Add(c.cfg.Window)
+
+	found, err := c.store.FindStories(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to find stories: %w", err)
+	}
+
+	pool := make([]*domain.Story, 0, len(found)+len(articles))
+	byArticle := make(map[string]*domain.Story)
+	for i := range found {
+		pool = append(pool, &found[i])
+		for _, id := range found[i].ArticleIDs {
+			byArticle[id] = &found[i]
+		}
+	}
+
+	added := make(map[*domain.Story][]string)
+	var touched []*domain.Story
+	for i, a := range articles {
+		// Articles keep their story across updates
+		if story, ok := byArticle[a.ID]; ok {
+			a.StoryID = story.ID
+			continue
+		}
+
+		f := features[i]
+		story, score := c.bestMatch(f, pool)
+		if story == nil || score < c.cfg.Threshold {
+			story = &domain.Story{ID: "story-" + a.ID, Title: a.Title, FirstPublishedAt: f.published, LastPublishedAt: f.published}
+			pool = append(pool, story)
+			metrics.StoryAssignments.WithLabelValues("created").Inc()
+		} else {
+			metrics.StoryAssignments.WithLabelValues("joined").Inc()
+		}
+
+		mergeIntoStory(story, a.ID, f)
+		story.UpdatedAt = now
+		a.StoryID = story.ID
+		byArticle[a.ID] = story
+		if _, ok := added[story]; !ok {
+			touched = append(touched, story)
+		}
+		added[story] = append(added[story], a.ID)
+	}
+
+	for _, story := range touched {
+		if err := c.store.SaveStory(ctx, story); err != nil {
+			return fmt.Errorf("failed to save story %s: %w", story.ID, err)
+		}
+	}
+
+	for _, story := range touched {
+		if len(story.ArticleIDs) < 2 {
+			continue
+		}
+		event := domain.StoryUpdatedEvent{
+			StoryID:       story.ID,
+			Title:         story.Title,
+			ArticleIDs:    story.ArticleIDs,
+			AddedArticles: added[story],
+			UpdatedAt:     story.UpdatedAt,
+		}
+		if err := c.events.PublishStoryUpdated(ctx, event); err != nil {
+			// The story is saved; consumers catch up on its next update
+			slog.Error("Failed to publish story update", "story_id", story.ID, "error", err)
+			metrics.StoryEventErrors.Inc()
+		}
+	}
+	return nil
+}
+
+func (c *StoryClusterer) features(a *domain.Article, now time.Time) storyFeatures {
+	body := a.BodyText
+	if body == "" {
+		body = textproc.ToText(a.Body)
+	}
+	// The headline and standfirst say what the story is about; count them twice
+	headline := a.Title + "\n" + a.Description
+	terms := textproc.TermFrequencies(headline + "\n" + headline + "\n" + body)
+
+	f := storyFeatures{terms: terms, published: a.PublishedAt}
+	if f.published.IsZero() {
+		f.published = now
+	}
+	for _, e := range a.Entities {
+		f.entities = appendUnique(f.entities, e.ID)
+	}
+	for _, t := range a.CanonicalTags {
+		f.tags = appendUnique(f.tags, t.ID)
+	}
+	for _, t := range a.Tags {
+		f.tags = appendUnique(f.tags, "label:"+strings.ToLower(t.Label))
+	}
+	return f
+}
+
+// bestMatch returns the story most similar to the article features.
+func (c *StoryClusterer) bestMatch(f storyFeatures, stories []*domain.Story) (*domain.Story, float64) {
+	var best *domain.Story
+	bestScore := 0.0
+	for _, story := range stories {
+		if score := c.similarity(f, story); score > bestScore {
+			best, bestScore = story, score
+		}
+	}
+	return best, bestScore
+}
+
+// similarity scores an article against a story in [0, 1]: weighted text,
+// entity and tag overlap, fading with the time between them.
+func (c *StoryClusterer) similarity(f storyFeatures, story *domain.Story) float64 {
+	var gap time.Duration
+	switch {
+	case f.published.Before(story.FirstPublishedAt):
+		gap = story.FirstPublishedAt.Sub(f.published)
+	case f.published.After(story.LastPublishedAt):
+		gap = f.published.Sub(story.LastPublishedAt)
+	}
+	if gap > c.cfg.Window {
+		return 0
+	}
+
+	score := storyTextWeight*textproc.CosineSimilarity(f.terms, story.Terms) +
+		storyEntityWeight*jaccard(f.entities, story.EntityIDs) +
+		storyTagWeight*jaccard(f.tags, story.TagIDs)
+	return score * (1 - 0.5*float64(gap)/float64(c.cfg.Window))
+}
+
+// mergeIntoStory adds an article's features to the story.
+func mergeIntoStory(story *domain.Story, articleID string, f storyFeatures) {
+	story.ArticleIDs = appendUnique(story.ArticleIDs, articleID)
+	for _, id := range f.entities {
+		story.EntityIDs = appendUnique(story.EntityIDs, id)
+	}
+	for _, id := range f.tags {
+		story.TagIDs = appendUnique(story.TagIDs, id)
+	}
+	if f.published.Before(story.FirstPublishedAt) {
+		story.FirstPublishedAt = f.published
+	}
+	if f.published.After(story.LastPublishedAt) {
+		story.LastPublishedAt = f.published
+	}
+
+	terms := make(map[string]float64, len(story.Terms)+len(f.terms))
+	for t, w := range story.Terms {
+		terms[t] = w
+	}
+	for t, w := range f.terms {
+		terms[t] += w
+	}
+	story.Terms = topTerms(terms, storyMaxTerms)
+}
+
+// topTerms keeps the n heaviest terms.
+func topTerms(terms map[string]float64, n int) map[string]float64 {
+	if len(terms) <= n {
+		return terms
+	}
+	keys := make([]string, 0, len(terms))
+	for t := range terms {
+		keys = append(keys, t)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if terms[keys[i]] != terms[keys[j]] {
+			return terms[keys[i]] > terms[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	top := make(map[string]float64, n)
+	for _, t := range keys[:n] {
+		top[t] = terms[t]
+	}
+	return top
+}
+
+func jaccard(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = true
+	}
+	shared := 0
+	for _, s := range b {
+		if set[s] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, item := range list {
+		if item == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
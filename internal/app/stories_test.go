@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStoryStore struct {
+	stories map[string]domain.Story
+}
+
+func (m *memoryStoryStore) FindStories(_ context.Context, q domain.StoryQuery) ([]domain.Story, error) {
+	var out []domain.Story
+	for _, s := range m.stories {
+		if s.LastPublishedAt.Before(q.From) || s.FirstPublishedAt.After(q.To) {
+			continue
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func (m *memoryStoryStore) SaveStory(_ context.Context, s *domain.Story) error {
+	m.stories[s.ID] = *s
+	return nil
+}
+
+type recordingStoryPublisher struct {
+	events []domain.StoryUpdatedEvent
+}
+
+func (r *recordingStoryPublisher) PublishStoryUpdated(_ context.Context, e domain.StoryUpdatedEvent) error {
+	r.events = append(r.events, e)
+	return nil
+}
+
+func TestStoryClusterer_Assign(t *testing.T) {
+	ctx := context.Background()
+	published := time.Date(2026, 7, 1, 18, 0, 0, 0, time.UTC)
+	england := domain.EntityRef{ID: "team:england-men", Type: domain.EntityTeam, Name: "England"}
+	australia := domain.EntityRef{ID: "team:australia-men", Type: domain.EntityTeam, Name: "Australia"}
+
+	store := &memoryStoryStore{stories: map[string]domain.Story{}}
+	events := &recordingStoryPublisher{}
+	clusterer, err := NewStoryClusterer(store, events, StoryConfig{Window: 48 * time.Hour, Threshold: 0.35})
+	require.NoError(t, err)
+
+	report := &domain.Article{
+		ID: "ecb-1", Title: "England beat Australia by 45 runs at Lord's",
+		BodyText:    "Joe Root's century and Mark Wood's five wickets gave England victory over Australia in the first Test at Lord's.",
+		Entities:    []domain.EntityRef{england, australia},
+		PublishedAt: published,
+	}
+	require.NoError(t, clusterer.Assign(ctx, []*domain.Article{report}))
+	assert.Equal(t, "story-ecb-1", report.StoryID)
+	assert.Empty(t, events.events, "a single-article story has not grown")
+
+	reaction := &domain.Article{
+		ID: "wire-7", Title: "Root and Wood star as England beat Australia at Lord's",
+		BodyText:    "England beat Australia by 45 runs in the first Test at Lord's after a Root century and five wickets for Wood.",
+		Entities:    []domain.EntityRef{england, australia},
+		PublishedAt: published.Add(3 * time.Hour),
+	}
+	county := &domain.Article{
+		ID: "ecb-2", Title: "Surrey go top after innings win over Somerset",
+		BodyText:    "Jamie Overton and Dan Worrall shared eight wickets at the Oval as Surrey beat Somerset by an innings.",
+		PublishedAt: published.Add(time.Hour),
+	}
+	require.NoError(t, clusterer.Assign(ctx, []*domain.Article{reaction, county}))
+
+	assert.Equal(t, "story-ecb-1", reaction.StoryID)
+	assert.Equal(t, "story-ecb-2", county.StoryID)
+	require.Len(t, events.events, 1)
+	assert.Equal(t, "story-ecb-1", events.events[0].StoryID)
+	assert.Equal(t, []string{"ecb-1", "wire-7"}, events.events[0].ArticleIDs)
+	assert.Equal(t, []string{"wire-7"}, events.events[0].AddedArticles)
+
+	// An updated article stays in its story without a new event
+	report.StoryID = ""
+	require.NoError(t, clusterer.Assign(ctx, []*domain.Article{report}))
+	assert.Equal(t, "story-ecb-1", report.StoryID)
+	assert.Len(t, events.events, 1)
+}
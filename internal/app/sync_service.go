@@ -13,12 +13,14 @@ import (
 type CMSSyncService struct {
 	consumer   *queue.KafkaConsumer
 	cmsGateway domain.CMSGateway
+	lifecycle  *LifecycleTracker // Records the outcome of each sync
 }
 
-func NewCMSSyncService(consumer *queue.KafkaConsumer, cmsGateway domain.CMSGateway) *CMSSyncService {
+func NewCMSSyncService(consumer *queue.KafkaConsumer, cmsGateway domain.CMSGateway, lifecycle *LifecycleTracker) *CMSSyncService {
 	return &CMSSyncService{
 		consumer:   consumer,
 		cmsGateway: cmsGateway,
+		lifecycle:  lifecycle,
 	}
 }
 
@@ -32,20 +34,44 @@ func (s *CMSSyncService) handleEvent(ctx context.Context, article *domain.Articl
 	// Here we receive the article from Kafka and sync it to the CMS
 	slog.Info("Consuming event for sync", "article_id", article.ID, "title", article.Title)
 
-	err := s.cmsGateway.SyncArticle(ctx, article)
+	var err error
+	if article.WithdrawnAt != nil {
+		// Tombstone: the publisher removed the article
+		slog.Info("Deleting withdrawn article from CMS", "article_id", article.ID)
+		err = s.cmsGateway.DeleteArticle(ctx, article)
+	} else {
+		err = s.cmsGateway.SyncArticle(ctx, article)
+	}
 	duration := time.Since(start).Seconds()
 	metrics.CMSSyncDuration.Observe(duration)
 
 	if err != nil {
 		slog.Error("Failed to sync article to CMS", "article_id", article.ID, "error", err)
 		metrics.CMSSyncErrors.WithLabelValues(article.Source).Inc()
+		s.recordSync(ctx, article, domain.LifecycleSyncFailed, start, err.Error())
 		return err
 	}
 
 	metrics.CMSSyncSuccess.WithLabelValues(article.Source).Inc()
+	state := domain.LifecycleSynced
+	if article.WithdrawnAt != nil {
+		state = domain.LifecycleWithdrawn
+	}
+	s.recordSync(ctx, article, state, start, "")
 	return nil
 }
 
+// recordSync records the outcome of a sync on the stored article; a failure
+// to do so does not fail the sync.
+func (s *CMSSyncService) recordSync(ctx context.Context, article *domain.Article, state domain.LifecycleState, at time.Time, errMsg string) {
+	if s.lifecycle == nil {
+		return
+	}
+	if err := s.lifecycle.Record(ctx, []string{article.ID}, state, at, errMsg); err != nil {
+		slog.Error("Failed to record sync outcome", "article_id", article.ID, "state", state, "error", err)
+	}
+}
+
 func (s *CMSSyncService) Stop() error {
 	return s.consumer.Close()
 }
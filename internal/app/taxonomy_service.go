@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// TaxonomyRuntime is the ingestion side of the taxonomy: the mappings in use.
+type TaxonomyRuntime interface {
+	Reload(ctx context.Context) error
+	Flush(ctx context.Context) error
+	MapTags(source string, tags []domain.Tag) ([]domain.CanonicalTag, []domain.Tag)
+}
+
+// TaxonomyService manages the canonical taxonomy and tag mappings. Changes are
+// applied to ingestion immediately.
+type TaxonomyService struct {
+	store   domain.TaxonomyStore
+	runtime TaxonomyRuntime
+}
+
+func NewTaxonomyService(store domain.TaxonomyStore, runtime TaxonomyRuntime) *TaxonomyService {
+	return &TaxonomyService{store: store, runtime: runtime}
+}
+
+func (s *TaxonomyService) ListCanonicalTags(ctx context.Context) ([]domain.CanonicalTag, error) {
+	return s.store.ListCanonicalTags(ctx)
+}
+
+func (s *TaxonomyService) PutCanonicalTag(ctx context.Context, tag domain.CanonicalTag) error {
+	tag.ID = strings.TrimSpace(tag.ID)
+	tag.Label = strings.TrimSpace(tag.Label)
+	if tag.ID == "" || tag.Label == "" {
+		return fmt.Errorf("%w: id and label are required", domain.ErrInvalidInput)
+	}
+	if err := s.store.UpsertCanonicalTag(ctx, tag); err != nil {
+		return err
+	}
+	return s.reload(ctx)
+}
+
+func (s *TaxonomyService) ListMappings(ctx context.Context) ([]domain.TagMapping, error) {
+	return s.store.ListTagMappings(ctx)
+}
+
+// SaveMapping creates or replaces a mapping after checking its pattern and target.
+func (s *TaxonomyService) SaveMapping(ctx context.Context, mapping *domain.TagMapping) error {
+	if err := mapping.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+	}
+
+	tags, err := s.store.ListCanonicalTags(ctx)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, t := range tags {
+		if t.ID == mapping.CanonicalID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: unknown canonical tag %q", domain.ErrInvalidInput, mapping.CanonicalID)
+	}
+
+	mapping.UpdatedAt = time.Now()
+	if err := s.store.SaveTagMapping(ctx, mapping); err != nil {
+		return err
+	}
+	return s.reload(ctx)
+}
+
+func (s *TaxonomyService) DeleteMapping(ctx context.Context, id string) error {
+	if err := s.store.DeleteTagMapping(ctx, id); err != nil {
+		return err
+	}
+	return s.reload(ctx)
+}
+
+// ListUnmapped returns the tags seen since the given time that the current
+// mappings still do not cover, most frequent first.
+func (s *TaxonomyService) ListUnmapped(ctx context.Context, since time.Time, limit int) ([]domain.UnmappedTag, error) {
+	if err := s.runtime.Flush(ctx); err != nil {
+		slog.Warn("Failed to flush unmapped tags, listing stored ones", "error", err)
+	}
+
+	seen, err := s.store.ListUnmappedTags(ctx, since, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	unmapped := make([]domain.UnmappedTag, 0, len(seen))
+	for _, t := range seen {
+		if _, still := s.runtime.MapTags(t.Source, []domain.Tag{{ID: t.TagID, Label: t.Label}}); len(still) == 0 {
+			continue
+		}
+		unmapped = append(unmapped, t)
+		if limit > 0 && len(unmapped) == limit {
+			break
+		}
+	}
+	return unmapped, nil
+}
+
+func (s *TaxonomyService) reload(ctx context.Context) error {
+	if err := s.runtime.Reload(ctx); err != nil {
+		return fmt.Errorf("saved, but failed to apply taxonomy: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryTaxonomyStore struct {
+	tags     []domain.CanonicalTag
+	mappings []domain.TagMapping
+	unmapped []domain.UnmappedTag
+}
+
+func (m *memoryTaxonomyStore) ListCanonicalTags(context.Context) ([]domain.CanonicalTag, error) {
+	return m.tags, nil
+}
+
+func (m *memoryTaxonomyStore) UpsertCanonicalTag(_ context.Context, tag domain.CanonicalTag) error {
+	m.tags = append(m.tags, tag)
+	return nil
+}
+
+func (m *memoryTaxonomyStore) ListTagMappings(context.Context) ([]domain.TagMapping, error) {
+	return m.mappings, nil
+}
+
+func (m *memoryTaxonomyStore) SaveTagMapping(_ context.Context, mapping *domain.TagMapping) error {
+	mapping.ID = "m1"
+	m.mappings = append(m.mappings, *mapping)
+	return nil
+}
+
+func (m *memoryTaxonomyStore) DeleteTagMapping(context.Context, string) error {
+	return domain.ErrNotFound
+}
+
+func (m *memoryTaxonomyStore) RecordUnmappedTags(context.Context, []domain.UnmappedTag) error {
+	return nil
+}
+
+func (m *memoryTaxonomyStore) ListUnmappedTags(context.Context, time.Time, int) ([]domain.UnmappedTag, error) {
+	return m.unmapped, nil
+}
+
+// labelRuntime maps tags whose label is in mapped.
+type labelRuntime struct {
+	mapped  map[string]bool
+	reloads int
+}
+
+func (r *labelRuntime) Reload(context.Context) error { r.reloads++; return nil }
+func (r *labelRuntime) Flush(context.Context) error  { return nil }
+
+func (r *labelRuntime) MapTags(_ string, tags []domain.Tag) ([]domain.CanonicalTag, []domain.Tag) {
+	var canonical []domain.CanonicalTag
+	var unmapped []domain.Tag
+	for _, t := range tags {
+		if r.mapped[t.Label] {
+			canonical = append(canonical, domain.CanonicalTag{ID: t.Label})
+		} else {
+			unmapped = append(unmapped, t)
+		}
+	}
+	return canonical, unmapped
+}
+
+func TestTaxonomyService_SaveMapping(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryTaxonomyStore{tags: []domain.CanonicalTag{{ID: "england-men", Label: "England Men"}}}
+	runtime := &labelRuntime{}
+	svc := NewTaxonomyService(store, runtime)
+
+	t.Run("unknown canonical tag", func(t *testing.T) {
+		err := svc.SaveMapping(ctx, &domain.TagMapping{Source: "*", MatchType: domain.TagMatchExact, Pattern: "England", CanonicalID: "nope"})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("invalid regex", func(t *testing.T) {
+		err := svc.SaveMapping(ctx, &domain.TagMapping{Source: "*", MatchType: domain.TagMatchRegex, Pattern: "(", CanonicalID: "england-men"})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("saved and applied", func(t *testing.T) {
+		mapping := &domain.TagMapping{Source: "pulselive", MatchType: domain.TagMatchAlias, Pattern: "england men", CanonicalID: "england-men"}
+		require.NoError(t, svc.SaveMapping(ctx, mapping))
+		assert.Equal(t, "m1", mapping.ID)
+		assert.False(t, mapping.UpdatedAt.IsZero())
+		assert.Equal(t, 1, runtime.reloads)
+	})
+}
+
+func TestTaxonomyService_ListUnmapped(t *testing.T) {
+	store := &memoryTaxonomyStore{unmapped: []domain.UnmappedTag{
+		{Source: "pulselive", Label: "Ashes", Count: 9},
+		{Source: "pulselive", Label: "England", Count: 5},
+		{Source: "pulselive", Label: "Lord's", Count: 2},
+	}}
+	svc := NewTaxonomyService(store, &labelRuntime{mapped: map[string]bool{"Ashes": true}})
+
+	tags, err := svc.ListUnmapped(context.Background(), time.Now().Add(-time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "England", tags[0].Label)
+}
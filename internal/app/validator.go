@@ -0,0 +1,176 @@
+package app
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// ValidationConfig configures the rules applied to transformed articles.
+type ValidationConfig struct {
+	RequiredFields   []string      // Article fields that must be non-empty (e.g. "title", "published_at")
+	MaxTitleLength   int           // In characters; 0 disables the check
+	MaxSummaryLength int           // In characters; 0 disables the check
+	MaxBodyLength    int           // In characters; 0 disables the check
+	MaxFutureSkew    time.Duration // How far in the future PublishedAt may be; 0 disables the check
+	MaxAge           time.Duration // How old PublishedAt may be; 0 disables the check
+}
+
+// ValidationRule checks one aspect of an article. Check returns an empty
+// string when the article passes, or a human-readable reason otherwise.
+type ValidationRule interface {
+	Name() string
+	Check(article *domain.Article) string
+}
+
+// Validator runs a fixed set of rules against each article.
+type Validator struct {
+	rules []ValidationRule
+}
+
+// NewValidator builds the rule set described by cfg.
+func NewValidator(cfg ValidationConfig) (*Validator, error) {
+	var rules []ValidationRule
+
+	if len(cfg.RequiredFields) > 0 {
+		for _, field := range cfg.RequiredFields {
+			if _, ok := requiredFieldCheckers[field]; !ok {
+				return nil, fmt.Errorf("unknown required field: %s", field)
+			}
+		}
+		rules = append(rules, &requiredFieldsRule{fields: cfg.RequiredFields})
+	}
+
+	rules = append(rules, &urlFormatRule{})
+
+	if cfg.MaxFutureSkew > 0 || cfg.MaxAge > 0 {
+		rules = append(rules, &dateSanityRule{maxFutureSkew: cfg.MaxFutureSkew, maxAge: cfg.MaxAge, now: time.Now})
+	}
+
+	lengths := []struct {
+		field string
+		max   int
+		value func(*domain.Article) string
+	}{
+		{"title", cfg.MaxTitleLength, func(a *domain.Article) string { return a.Title }},
+		{"summary", cfg.MaxSummaryLength, func(a *domain.Article) string { return a.Summary }},
+		{"body", cfg.MaxBodyLength, func(a *domain.Article) string { return a.Body }},
+	}
+	for _, l := range lengths {
+		if l.max > 0 {
+			rules = append(rules, &maxLengthRule{field: l.field, max: l.max, value: l.value})
+		}
+	}
+
+	return &Validator{rules: rules}, nil
+}
+
+// Validate returns every rule the article violates; an empty result means it is valid.
+func (v *Validator) Validate(article *domain.Article) []domain.Violation {
+	var violations []domain.Violation
+	for _, rule := range v.rules {
+		if msg := rule.Check(article); msg != "" {
+			violations = append(violations, domain.Violation{Rule: rule.Name(), Message: msg})
+		}
+	}
+	return violations
+}
+
+var requiredFieldCheckers = map[string]func(*domain.Article) bool{
+	"id":           func(a *domain.Article) bool { return a.ID != "" },
+	"external_id":  func(a *domain.Article) bool { return a.ExternalID != "" },
+	"source":       func(a *domain.Article) bool { return a.Source != "" },
+	"type":         func(a *domain.Article) bool { return a.Type != "" },
+	"title":        func(a *domain.Article) bool { return strings.TrimSpace(a.Title) != "" },
+	"description":  func(a *domain.Article) bool { return strings.TrimSpace(a.Description) != "" },
+	"summary":      func(a *domain.Article) bool { return strings.TrimSpace(a.Summary) != "" },
+	"body":         func(a *domain.Article) bool { return strings.TrimSpace(a.Body) != "" },
+	"url":          func(a *domain.Article) bool { return a.URL != "" },
+	"image_url":    func(a *domain.Article) bool { return a.ImageURL != "" },
+	"published_at": func(a *domain.Article) bool { return !a.PublishedAt.IsZero() },
+}
+
+type requiredFieldsRule struct {
+	fields []string
+}
+
+func (r *requiredFieldsRule) Name() string { return "required_fields" }
+
+func (r *requiredFieldsRule) Check(a *domain.Article) string {
+	var missing []string
+	for _, field := range r.fields {
+		if !requiredFieldCheckers[field](a) {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) == 0 {
+		return ""
+	}
+	return "missing required fields: " + strings.Join(missing, ", ")
+}
+
+// urlFormatRule rejects URLs that are not absolute http(s) URLs. An empty URL
+// is left to the required_fields rule.
+type urlFormatRule struct{}
+
+func (r *urlFormatRule) Name() string { return "url_format" }
+
+func (r *urlFormatRule) Check(a *domain.Article) string {
+	if a.URL == "" {
+		return ""
+	}
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return fmt.Sprintf("invalid url %q: %v", a.URL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Sprintf("url %q must use http or https", a.URL)
+	}
+	if u.Host == "" {
+		return fmt.Sprintf("url %q has no host", a.URL)
+	}
+	return ""
+}
+
+// dateSanityRule rejects publication dates too far in the future or the past.
+// A zero date is left to the required_fields rule.
+type dateSanityRule struct {
+	maxFutureSkew time.Duration
+	maxAge        time.Duration
+	now           func() time.Time
+}
+
+func (r *dateSanityRule) Name() string { return "date_sanity" }
+
+func (r *dateSanityRule) Check(a *domain.Article) string {
+	if a.PublishedAt.IsZero() {
+		return ""
+	}
+	now := r.now()
+	if r.maxFutureSkew > 0 && a.PublishedAt.After(now.Add(r.maxFutureSkew)) {
+		return fmt.Sprintf("published_at %s is more than %s in the future", a.PublishedAt.Format(time.RFC3339), r.maxFutureSkew)
+	}
+	if r.maxAge > 0 && a.PublishedAt.Before(now.Add(-r.maxAge)) {
+		return fmt.Sprintf("published_at %s is older than %s", a.PublishedAt.Format(time.RFC3339), r.maxAge)
+	}
+	return ""
+}
+
+type maxLengthRule struct {
+	field string
+	max   int
+	value func(*domain.Article) string
+}
+
+func (r *maxLengthRule) Name() string { return "max_length_" + r.field }
+
+func (r *maxLengthRule) Check(a *domain.Article) string {
+	if n := utf8.RuneCountInString(r.value(a)); n > r.max {
+		return fmt.Sprintf("%s has %d characters, max is %d", r.field, n, r.max)
+	}
+	return ""
+}
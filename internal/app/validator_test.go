@@ -0,0 +1,69 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validArticle() domain.Article {
+	return domain.Article{
+		ID:          "src_1",
+		Title:       "England win",
+		URL:         "https://www.ecb.co.uk/news/1",
+		Body:        "<p>Body</p>",
+		PublishedAt: time.Now().Add(-time.Hour),
+	}
+}
+
+func rulesOf(violations []domain.Violation) []string {
+	rules := make([]string, 0, len(violations))
+	for _, v := range violations {
+		rules = append(rules, v.Rule)
+	}
+	return rules
+}
+
+func TestValidator_Rules(t *testing.T) {
+	v, err := NewValidator(ValidationConfig{
+		RequiredFields: []string{"id", "title", "url", "published_at"},
+		MaxTitleLength: 20,
+		MaxFutureSkew:  time.Hour,
+		MaxAge:         24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		mutate func(a *domain.Article)
+		rules  []string
+	}{
+		{"valid", func(a *domain.Article) {}, []string{}},
+		{"empty title and zero date", func(a *domain.Article) {
+			a.Title = "  "
+			a.PublishedAt = time.Time{}
+		}, []string{"required_fields"}},
+		{"relative url", func(a *domain.Article) { a.URL = "/news/1" }, []string{"url_format"}},
+		{"ftp url", func(a *domain.Article) { a.URL = "ftp://host/file" }, []string{"url_format"}},
+		{"future date", func(a *domain.Article) { a.PublishedAt = time.Now().Add(48 * time.Hour) }, []string{"date_sanity"}},
+		{"stale date", func(a *domain.Article) { a.PublishedAt = time.Now().Add(-72 * time.Hour) }, []string{"date_sanity"}},
+		{"long title", func(a *domain.Article) { a.Title = strings.Repeat("é", 21) }, []string{"max_length_title"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := validArticle()
+			tt.mutate(&a)
+			assert.Equal(t, tt.rules, rulesOf(v.Validate(&a)))
+		})
+	}
+}
+
+func TestValidator_UnknownRequiredField(t *testing.T) {
+	_, err := NewValidator(ValidationConfig{RequiredFields: []string{"headline"}})
+	assert.Error(t, err)
+}
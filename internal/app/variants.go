@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// VariantLinker links the translations of an article: the versions carrying
+// the same upstream ID in the feeds of one publisher in different languages.
+type VariantLinker struct {
+	store   domain.VariantStore
+	primary string // Articles in this language keep their feed's ID
+}
+
+func NewVariantLinker(store domain.VariantStore, primaryLanguage string) (*VariantLinker, error) {
+	if primaryLanguage == "" {
+		return nil, fmt.Errorf("primary language is required")
+	}
+	return &VariantLinker{store: store, primary: strings.ToLower(primaryLanguage)}, nil
+}
+
+// Identify sets the variant group of articles with an upstream ID and, so that
+// translations do not overwrite each other, suffixes the ID of those declared
+// in another language than the primary one with the language code.
+func (l *VariantLinker) Identify(articles []domain.Article) {
+	for i := range articles {
+		a := &articles[i]
+		if a.ExternalID == "" {
+			continue
+		}
+		a.VariantGroup = a.Source + "_" + a.ExternalID
+		if suffix := "_" + a.Language; a.Language != "" && a.Language != l.primary && !strings.HasSuffix(a.ID, suffix) {
+			a.ID += suffix
+		}
+	}
+}
+
+// Link sets Variants on each article to its group's other stored and batched
+// language versions.
+func (l *VariantLinker) Link(ctx context.Context, articles []*domain.Article) error {
+	var groups []string
+	batched := make(map[string][]domain.Variant)
+	for _, a := range articles {
+		if a.VariantGroup == "" {
+			continue
+		}
+		if _, ok := batched[a.VariantGroup]; !ok {
+			groups = append(groups, a.VariantGroup)
+		}
+		batched[a.VariantGroup] = append(batched[a.VariantGroup], variantOf(a))
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	stored, err := l.store.FindVariants(ctx, groups)
+	if err != nil {
+		return fmt.Errorf("failed to find variants: %w", err)
+	}
+
+	for _, a := range articles {
+		if a.VariantGroup == "" {
+			continue
+		}
+		seen := map[string]bool{a.ID: true}
+		a.Variants = nil
+		// Batched versions are newer than their stored copy
+		for _, v := range append(batched[a.VariantGroup], stored[a.VariantGroup]...) {
+			if !seen[v.ID] {
+				seen[v.ID] = true
+				a.Variants = append(a.Variants, v)
+			}
+		}
+		sortVariants(a.Variants)
+	}
+	return nil
+}
+
+func variantOf(a *domain.Article) domain.Variant {
+	return domain.Variant{ID: a.ID, Language: a.Language, URL: a.URL, Title: a.Title}
+}
+
+// sortVariants orders variants by language, then ID.
+func sortVariants(variants []domain.Variant) {
+	sort.Slice(variants, func(i, j int) bool {
+		if variants[i].Language != variants[j].Language {
+			return variants[i].Language < variants[j].Language
+		}
+		return variants[i].ID < variants[j].ID
+	})
+}
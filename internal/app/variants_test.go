@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryVariants map[string][]domain.Variant
+
+func (m memoryVariants) FindVariants(_ context.Context, groups []string) (map[string][]domain.Variant, error) {
+	out := make(map[string][]domain.Variant)
+	for _, g := range groups {
+		out[g] = append(out[g], m[g]...)
+	}
+	return out, nil
+}
+
+func TestVariantLinker(t *testing.T) {
+	store := memoryVariants{"pulselive_42": {
+		{ID: "pulselive_42", Language: "en", URL: "https://ecb.co.uk/en/42", Title: "Root hits century"},
+		{ID: "pulselive_42_fr", Language: "fr", URL: "https://ecb.co.uk/fr/42", Title: "Root marque un siècle"},
+	}}
+	linker, err := NewVariantLinker(store, "en")
+	require.NoError(t, err)
+
+	articles := []domain.Article{
+		{ID: "pulselive_42", Source: "pulselive", ExternalID: "42", Language: "hi", URL: "https://ecb.co.uk/hi/42"},
+		{ID: "pulselive_43", Source: "pulselive", ExternalID: "43", Language: "en"},
+		{ID: "manual", Source: "pulselive"},
+	}
+	linker.Identify(articles)
+	linker.Identify(articles)
+
+	assert.Equal(t, "pulselive_42_hi", articles[0].ID, "translations get their own ID, once")
+	assert.Equal(t, "pulselive_43", articles[1].ID, "the primary language keeps the feed's ID")
+	assert.Equal(t, "pulselive_42", articles[0].VariantGroup)
+	assert.Empty(t, articles[2].VariantGroup, "no upstream ID to link on")
+
+	require.NoError(t, linker.Link(context.Background(), []*domain.Article{&articles[0], &articles[1], &articles[2]}))
+
+	var languages []string
+	for _, v := range articles[0].Variants {
+		languages = append(languages, v.Language)
+	}
+	assert.Equal(t, []string{"en", "fr"}, languages)
+	assert.Empty(t, articles[1].Variants)
+	assert.Empty(t, articles[2].Variants)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"slices"
 	"time"
 )
 
@@ -25,6 +27,70 @@ type Article struct {
 	UpdatedAt   time.Time `json:"updated_at" bson:"updated_at"`
 	FetchedAt   time.Time `json:"fetched_at" bson:"fetched_at"`
 	ContentHash string    `json:"content_hash" bson:"content_hash"` // New field for deduplication
+
+	// Derived from the sanitized Body during ingestion
+	BodyText           string `json:"body_text" bson:"body_text"`
+	BodyMarkdown       string `json:"body_markdown" bson:"body_markdown"`
+	WordCount          int    `json:"word_count" bson:"word_count"`
+	ReadingTimeMinutes int    `json:"reading_time_minutes" bson:"reading_time_minutes"`
+
+	Entities      []EntityRef    `json:"entities,omitempty" bson:"entities,omitempty"`             // Gazetteer matches in title and body
+	CanonicalTags []CanonicalTag `json:"canonical_tags,omitempty" bson:"canonical_tags,omitempty"` // Tags mapped onto the shared taxonomy
+	Keywords      []string       `json:"keywords,omitempty" bson:"keywords,omitempty"`             // Key words and phrases of the text
+
+	DuplicateOf string `json:"duplicate_of,omitempty" bson:"duplicate_of,omitempty"` // Primary article this one duplicates; only primaries are published
+	StoryID     string `json:"story_id,omitempty" bson:"story_id,omitempty"`         // Story cluster the article belongs to
+	// Secondaries lists the other sources' copies of a primary article.
+	Secondaries []SecondarySource `json:"secondaries,omitempty" bson:"secondaries,omitempty"`
+
+	OriginalURL string `json:"original_url,omitempty" bson:"original_url,omitempty"` // URL as received, when canonicalization changed it
+
+	// ChangedFields lists the fields that differ from the stored version. It is
+	// only set on events for updated articles and not persisted.
+	ChangedFields []string `json:"changed_fields,omitempty" bson:"-"`
+
+	Provider   string    `json:"provider,omitempty" bson:"provider,omitempty"` // Configured source that crawled the article
+	LastSeenAt time.Time `json:"last_seen_at" bson:"last_seen_at"`             // Last crawl that listed the article
+
+	// Media are the article's images, videos and galleries, lead media first.
+	// ImageURL remains the lead image.
+	Media []Media `json:"media,omitempty" bson:"media,omitempty"`
+
+	Authors []Author `json:"authors,omitempty" bson:"authors,omitempty"`
+	Rights  Rights   `json:"rights,omitzero" bson:"rights,omitempty"`
+	// EmbargoUntil is when the article may first be shown, if the publisher
+	// embargoed it.
+	EmbargoUntil *time.Time `json:"embargo_until,omitempty" bson:"embargo_until"`
+	// EmbargoHeld is set while the article's event waits for the embargo to end.
+	EmbargoHeld bool `json:"-" bson:"embargo_held,omitempty"`
+
+	// WithdrawnAt is set once the publisher removed the article. An event with
+	// it set is a tombstone: downstream systems delete the article.
+	WithdrawnAt *time.Time `json:"withdrawn_at,omitempty" bson:"withdrawn_at"`
+
+	// Language is the ISO 639-1 code of the article's language.
+	Language string `json:"language,omitempty" bson:"language,omitempty"`
+	// VariantGroup is shared by the translations of the same upstream article.
+	VariantGroup string `json:"variant_group,omitempty" bson:"variant_group,omitempty"`
+	// Variants lists the article's other language versions. It is set on
+	// events and not persisted.
+	Variants []Variant `json:"variants,omitempty" bson:"-"`
+
+	Sport    string `json:"sport,omitempty" bson:"sport,omitempty"`       // e.g. "cricket", set by the classifier
+	Category string `json:"category,omitempty" bson:"category,omitempty"` // e.g. "match_report", set by the classifier
+
+	MatchResult *MatchResult `json:"match_result,omitempty" bson:"match_result,omitempty"`
+	// MatchIDs are the ingested matches the article is about, referenced by
+	// the feed or linked on the teams and date.
+	MatchIDs []string `json:"match_ids,omitempty" bson:"match_ids,omitempty"`
+
+	// Review is set once the article matched a review rule. Its events are
+	// withheld until a reviewer approves it.
+	Review *ArticleReview `json:"review,omitempty" bson:"review,omitempty"`
+
+	// Lifecycle is how far the article got on its way to the CMS. It is
+	// maintained by the lifecycle store; upserts leave it unchanged.
+	Lifecycle *ArticleLifecycle `json:"lifecycle,omitempty" bson:"lifecycle,omitempty"`
 }
 
 // ComputeHash generates a deterministic hash of the article's content.
@@ -39,6 +105,45 @@ func (a *Article) ComputeHash() string {
 	return hex.EncodeToString(hasher.Sum(nil))
 }
 
+// Clone returns a deep copy of the article, so changes to the copy's slices
+// and referenced values leave the original untouched.
+func (a Article) Clone() Article {
+	a.Tags = slices.Clone(a.Tags)
+	a.Entities = slices.Clone(a.Entities)
+	a.CanonicalTags = slices.Clone(a.CanonicalTags)
+	a.Keywords = slices.Clone(a.Keywords)
+	a.Secondaries = slices.Clone(a.Secondaries)
+	a.ChangedFields = slices.Clone(a.ChangedFields)
+	a.Media = cloneMedia(a.Media)
+	a.Authors = slices.Clone(a.Authors)
+	a.Rights.Restrictions = slices.Clone(a.Rights.Restrictions)
+	a.EmbargoUntil = clonePtr(a.EmbargoUntil)
+	a.WithdrawnAt = clonePtr(a.WithdrawnAt)
+	a.Variants = slices.Clone(a.Variants)
+	if a.MatchResult != nil {
+		result := *a.MatchResult
+		result.Teams = slices.Clone(result.Teams)
+		a.MatchResult = &result
+	}
+	a.MatchIDs = slices.Clone(a.MatchIDs)
+	if a.Review != nil {
+		review := *a.Review
+		review.Rules = slices.Clone(review.Rules)
+		review.ReviewedAt = clonePtr(review.ReviewedAt)
+		a.Review = &review
+	}
+	a.Lifecycle = clonePtr(a.Lifecycle)
+	return a
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
 // Tag represents a content tag/category.
 type Tag struct {
 	ID    int    `json:"id" bson:"id"`
@@ -61,6 +166,12 @@ type HashReader interface {
 	GetContentHashes(ctx context.Context, ids []string) (map[string]string, error)
 }
 
+// URLReader looks up articles by their canonical URL.
+type URLReader interface {
+	// GetIDsByURL returns the ID of the article stored under each of the given URLs.
+	GetIDsByURL(ctx context.Context, urls []string) (map[string]string, error)
+}
+
 // Repository is a composite interface for backward compatibility.
 // Services should depend on specific interfaces (ArticleWriter, ArticleReader, HashReader)
 // rather than the full Repository when possible.
@@ -70,6 +181,10 @@ type Repository interface {
 	HashReader
 }
 
+// ErrCrawlTruncated is returned by a crawl that stopped before the end of its
+// feed. The pages it handled are kept, but it did not see every article.
+var ErrCrawlTruncated = errors.New("crawl truncated")
+
 // Provider defines the interface for external news feed providers.
 type Provider interface {
 	Crawl(ctx context.Context, handler func([]Article) error) error
@@ -86,4 +201,6 @@ type EventProducer interface {
 // CMSGateway defines the interface for communicating with the downstream Content Management System.
 type CMSGateway interface {
 	SyncArticle(ctx context.Context, article *Article) error
+	// DeleteArticle removes a withdrawn article from the CMS.
+	DeleteArticle(ctx context.Context, article *Article) error
 }
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// ClassificationField is the article field a classification rule votes for.
+type ClassificationField string
+
+const (
+	ClassifySport    ClassificationField = "sport"    // e.g. "cricket", "football", "rugby"
+	ClassifyCategory ClassificationField = "category" // e.g. "match_report", "transfer", "injury", "opinion"
+)
+
+// ClassificationRule adds Weight to Value for Field when it fires. A rule fires
+// when every condition it sets matches: any of its keywords, its regex, any of
+// its tags and any of its sources.
+type ClassificationRule struct {
+	ID     string              `json:"id"`
+	Field  ClassificationField `json:"field"`
+	Value  string              `json:"value"`
+	Weight float64             `json:"weight"` // Negative weights vote against Value
+
+	Keywords []string `json:"keywords,omitempty"` // Whole words or phrases in the title, description or body, ignoring case
+	Regex    string   `json:"regex,omitempty"`    // Matched against the title, description and body
+	Tags     []string `json:"tags,omitempty"`     // Source tag labels or canonical tag IDs, ignoring case
+	Sources  []string `json:"sources,omitempty"`  // Article.Source or Provider names
+}
+
+// Validate checks the rule is complete and its regex usable.
+func (r *ClassificationRule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if r.Field != ClassifySport && r.Field != ClassifyCategory {
+		return fmt.Errorf("unknown field %q", r.Field)
+	}
+	if r.Value == "" {
+		return fmt.Errorf("value is required")
+	}
+	if r.Weight == 0 {
+		return fmt.Errorf("weight is required")
+	}
+	if len(r.Keywords) == 0 && r.Regex == "" && len(r.Tags) == 0 && len(r.Sources) == 0 {
+		return fmt.Errorf("at least one of keywords, regex, tags or sources is required")
+	}
+	if r.Regex != "" {
+		if _, err := regexp.Compile(r.Regex); err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+	}
+	return nil
+}
+
+// RuleHit records a classification rule that fired and what it matched.
+type RuleHit struct {
+	RuleID  string              `json:"rule_id"`
+	Field   ClassificationField `json:"field"`
+	Value   string              `json:"value"`
+	Weight  float64             `json:"weight"`
+	Matched []string            `json:"matched"` // e.g. "keyword:wicket", "tag:Ashes", "source:ecb-pulselive"
+}
+
+// Classification is the outcome of classifying an article.
+type Classification struct {
+	Sport    string                                     `json:"sport"`
+	Category string                                     `json:"category"`
+	Scores   map[ClassificationField]map[string]float64 `json:"scores"`
+	Hits     []RuleHit                                  `json:"hits"`
+}
+
+// ClassificationRuleSource loads the classification rules.
+type ClassificationRuleSource interface {
+	LoadRules(ctx context.Context) ([]ClassificationRule, error)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Fingerprint is the near-duplicate signature of an article's normalized title and body.
+type Fingerprint struct {
+	ArticleID   string
+	Source      string
+	SimHash     uint64
+	Bands       []string // SimHash split into bands; near duplicates share at least one
+	CanonicalID string   // Article this one duplicates, or its own ID
+	PublishedAt time.Time
+	CreatedAt   time.Time
+}
+
+// FingerprintIndex stores article fingerprints for near-duplicate lookups.
+type FingerprintIndex interface {
+	// FindCandidates returns fingerprints sharing any band, published within [from, to].
+	FindCandidates(ctx context.Context, bands []string, from, to time.Time) ([]Fingerprint, error)
+	SaveFingerprints(ctx context.Context, fingerprints []Fingerprint) error
+}
+
+// DuplicateLinks clears the DuplicateOf link of articles that no longer
+// duplicate another. Upserts leave a stored link unchanged when it is empty.
+type DuplicateLinks interface {
+	Unlink(ctx context.Context, ids []string) error
+}
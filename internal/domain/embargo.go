@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// EmbargoStore finds the articles whose event is withheld until their embargo ends.
+type EmbargoStore interface {
+	// FindHeld returns held articles, earliest embargo first. A non-zero due
+	// only returns those whose embargo ends by then.
+	FindHeld(ctx context.Context, due time.Time, limit int) ([]Article, error)
+	CountHeld(ctx context.Context) (int64, error)
+	// MarkReleased records that the articles' events were published.
+	MarkReleased(ctx context.Context, ids []string) error
+}
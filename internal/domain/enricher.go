@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+// Enricher adds derived data to an article between transformation and persistence.
+// Implementations must be safe for concurrent use; on error the article is
+// restored to its state before the call.
+type Enricher interface {
+	Name() string
+	Enrich(ctx context.Context, article *Article) error
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "context"
+
+// EntityType classifies gazetteer entries.
+type EntityType string
+
+const (
+	EntityTeam        EntityType = "team"
+	EntityPlayer      EntityType = "player"
+	EntityCompetition EntityType = "competition"
+	EntityVenue       EntityType = "venue"
+)
+
+// Entity is a gazetteer entry: a known team, player, competition or venue and
+// the names it appears under in text.
+type Entity struct {
+	ID      string     `json:"id" bson:"_id"` // Stable, e.g. "team:england-men"
+	Type    EntityType `json:"type" bson:"type"`
+	Name    string     `json:"name" bson:"name"` // Canonical display name
+	Aliases []string   `json:"aliases" bson:"aliases"`
+}
+
+// EntityRef is a normalized reference from an article to a gazetteer entity.
+type EntityRef struct {
+	ID       string     `json:"id" bson:"id"`
+	Type     EntityType `json:"type" bson:"type"`
+	Name     string     `json:"name" bson:"name"`
+	Mentions int        `json:"mentions" bson:"mentions"`
+}
+
+// GazetteerSource loads the entities used for extraction.
+type GazetteerSource interface {
+	LoadEntities(ctx context.Context) ([]Entity, error)
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// LifecycleState is how far an article got on its way to the CMS.
+type LifecycleState string
+
+const (
+	LifecycleIngested   LifecycleState = "ingested"    // Persisted, its event not published yet
+	LifecyclePublished  LifecycleState = "published"   // Event published to Kafka
+	LifecycleSynced     LifecycleState = "synced"      // Accepted by the CMS
+	LifecycleSyncFailed LifecycleState = "sync_failed" // Rejected by the CMS, event sent to the DLQ
+	LifecycleWithdrawn  LifecycleState = "withdrawn"   // Tombstone published or the CMS deleted the article
+)
+
+// lifecycleTransitions lists the states each state may move to. The CMS sync
+// may record an event before the crawler records publishing it, so ingested
+// articles may be synced directly.
+var lifecycleTransitions = map[LifecycleState][]LifecycleState{
+	LifecycleIngested:   {LifecyclePublished, LifecycleSynced, LifecycleSyncFailed, LifecycleWithdrawn},
+	LifecyclePublished:  {LifecycleIngested, LifecycleSynced, LifecycleSyncFailed, LifecycleWithdrawn},
+	LifecycleSynced:     {LifecycleIngested, LifecyclePublished, LifecycleWithdrawn},
+	LifecycleSyncFailed: {LifecycleIngested, LifecyclePublished, LifecycleSynced, LifecycleWithdrawn},
+	LifecycleWithdrawn:  {LifecycleIngested, LifecyclePublished, LifecycleSyncFailed},
+}
+
+// Valid reports whether s is a known state.
+func (s LifecycleState) Valid() bool {
+	_, ok := lifecycleTransitions[s]
+	return ok
+}
+
+// CanTransition reports whether an article in state s may move to state to.
+// Articles stored before their state was tracked have none and may move to
+// any state.
+func (s LifecycleState) CanTransition(to LifecycleState) bool {
+	if !to.Valid() {
+		return false
+	}
+	if s == "" {
+		return true
+	}
+	for _, next := range lifecycleTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ArticleLifecycle is an article's current lifecycle state.
+type ArticleLifecycle struct {
+	State     LifecycleState `json:"state" bson:"state"`
+	ChangedAt time.Time      `json:"changed_at" bson:"changed_at"`
+	Error     string         `json:"error,omitempty" bson:"error,omitempty"` // Why the CMS sync failed
+}
+
+// LifecycleTransition records an article moving from one state to another.
+type LifecycleTransition struct {
+	ID        string         `json:"id" bson:"_id"`
+	ArticleID string         `json:"article_id" bson:"article_id"`
+	From      LifecycleState `json:"from,omitempty" bson:"from,omitempty"`
+	To        LifecycleState `json:"to" bson:"to"`
+	Error     string         `json:"error,omitempty" bson:"error,omitempty"`
+	At        time.Time      `json:"at" bson:"at"`
+}
+
+// LifecycleStore persists the lifecycle state of articles and its transitions.
+type LifecycleStore interface {
+	// GetLifecycles returns the lifecycle of each stored article among ids; a
+	// zero one if its state is not tracked yet.
+	GetLifecycles(ctx context.Context, ids []string) (map[string]ArticleLifecycle, error)
+	// ApplyTransitions moves each article to the transition's state if it is
+	// still in the transition's From state, and records the transitions
+	// applied, which it returns.
+	ApplyTransitions(ctx context.Context, transitions []LifecycleTransition) ([]LifecycleTransition, error)
+	// FindByLifecycleState returns the articles in a state, most recently
+	// changed first.
+	FindByLifecycleState(ctx context.Context, state LifecycleState, limit int) ([]Article, error)
+	// ListTransitions returns an article's transitions, newest first.
+	ListTransitions(ctx context.Context, articleID string, limit int) ([]LifecycleTransition, error)
+}
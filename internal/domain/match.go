@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// MatchStatus is where a match stands.
+type MatchStatus string
+
+const (
+	MatchScheduled MatchStatus = "scheduled"
+	MatchLive      MatchStatus = "live"
+	MatchCompleted MatchStatus = "completed"
+	MatchAbandoned MatchStatus = "abandoned"
+	MatchPostponed MatchStatus = "postponed"
+	MatchCancelled MatchStatus = "cancelled"
+)
+
+// Match is a fixture and, once played, its result, ingested from a match
+// source alongside articles.
+type Match struct {
+	ID          string      `json:"id" bson:"_id"`
+	Source      string      `json:"source" bson:"source"` // Transformer, e.g. "pulselive"
+	ExternalID  string      `json:"external_id" bson:"external_id"`
+	Sport       string      `json:"sport" bson:"sport"`
+	Competition string      `json:"competition,omitempty" bson:"competition,omitempty"`
+	Title       string      `json:"title" bson:"title"` // e.g. "England v Australia, 1st Test"
+	Venue       string      `json:"venue,omitempty" bson:"venue,omitempty"`
+	StartsAt    time.Time   `json:"starts_at" bson:"starts_at"`
+	Status      MatchStatus `json:"status" bson:"status"`
+	Teams       []MatchTeam `json:"teams" bson:"teams"`
+	// Result is the outcome as the source words it, e.g. "England won by 45 runs".
+	Result string `json:"result,omitempty" bson:"result,omitempty"`
+	Winner string `json:"winner,omitempty" bson:"winner,omitempty"` // Team name
+
+	ContentHash string    `json:"content_hash" bson:"content_hash"`
+	FetchedAt   time.Time `json:"fetched_at" bson:"fetched_at"`
+	Provider    string    `json:"provider,omitempty" bson:"provider,omitempty"` // Configured source that crawled the match
+	LastSeenAt  time.Time `json:"last_seen_at" bson:"last_seen_at"`
+}
+
+// MatchTeam is one side of a match.
+type MatchTeam struct {
+	Name       string `json:"name" bson:"name"`
+	ExternalID string `json:"external_id,omitempty" bson:"external_id,omitempty"`
+	Score      string `json:"score,omitempty" bson:"score,omitempty"` // As the source writes it, e.g. "325-7" or "2"
+}
+
+// ComputeHash returns a hash of the match's fixture and result details, so a
+// match is only republished when they change.
+func (m *Match) ComputeHash() string {
+	data, _ := json.Marshal(struct {
+		Sport, Competition, Title, Venue string
+		StartsAt                         time.Time
+		Status                           MatchStatus
+		Teams                            []MatchTeam
+		Result, Winner                   string
+	}{m.Sport, m.Competition, m.Title, m.Venue, m.StartsAt.UTC(), m.Status, m.Teams, m.Result, m.Winner})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchProvider crawls a fixtures and results feed.
+type MatchProvider interface {
+	Crawl(ctx context.Context, handler func([]Match) error) error
+	GetName() string
+}
+
+// MatchQuery selects stored matches.
+type MatchQuery struct {
+	From, To time.Time // Matches starting within this period
+	Team     string    // Optional; a team's name, ignoring case
+	Limit    int
+}
+
+// MatchStore persists matches in their own collection.
+type MatchStore interface {
+	GetMatchHashes(ctx context.Context, ids []string) (map[string]string, error)
+	BulkUpsertMatches(ctx context.Context, matches []Match) error
+	GetMatch(ctx context.Context, id string) (*Match, error) // ErrNotFound when missing
+	// FindMatches returns matches by start time.
+	FindMatches(ctx context.Context, query MatchQuery) ([]Match, error)
+}
+
+// MatchArticleReader finds the articles linked to a match.
+type MatchArticleReader interface {
+	FindArticlesByMatch(ctx context.Context, matchID string, limit int) ([]Article, error)
+}
+
+// MatchEventPublisher publishes new and changed matches.
+type MatchEventPublisher interface {
+	PublishMatches(ctx context.Context, matches []Match) error
+}
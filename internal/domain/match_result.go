@@ -0,0 +1,34 @@
+package domain
+
+// MatchOutcome is how a match ended.
+type MatchOutcome string
+
+const (
+	OutcomeWin  MatchOutcome = "win"
+	OutcomeDraw MatchOutcome = "draw"
+	OutcomeTie  MatchOutcome = "tie"
+)
+
+// MatchResult is the result of a match reported by an article, extracted from
+// its title, description or body.
+type MatchResult struct {
+	Sport       string       `json:"sport" bson:"sport"`
+	Competition string       `json:"competition,omitempty" bson:"competition,omitempty"` // From the article's competition entities
+	Outcome     MatchOutcome `json:"outcome" bson:"outcome"`
+	Winner      string       `json:"winner,omitempty" bson:"winner,omitempty"` // Team name, empty unless Outcome is win
+	Teams       []TeamScore  `json:"teams" bson:"teams"`                       // Winner first when there is one
+	// Margin is the winning margin as written, e.g. "45 runs", "an innings
+	// and 12 runs", "6 wickets" or "2 goals"; MarginValue and MarginUnit
+	// hold it as a number ("runs", "wickets", "goals" or "points").
+	Margin      string `json:"margin,omitempty" bson:"margin,omitempty"`
+	MarginValue int    `json:"margin_value,omitempty" bson:"margin_value,omitempty"`
+	MarginUnit  string `json:"margin_unit,omitempty" bson:"margin_unit,omitempty"`
+	Text        string `json:"text" bson:"text"` // Sentence the result was read from
+}
+
+// TeamScore is a team's side of a match result.
+type TeamScore struct {
+	Team     string `json:"team" bson:"team"`
+	EntityID string `json:"entity_id,omitempty" bson:"entity_id,omitempty"` // Gazetteer team, when the article mentions one by this name
+	Score    string `json:"score,omitempty" bson:"score,omitempty"`         // As written, e.g. "325-7", "2" or "24"
+}
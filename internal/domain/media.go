@@ -0,0 +1,47 @@
+package domain
+
+import "slices"
+
+// MediaType classifies article media.
+type MediaType string
+
+const (
+	MediaImage   MediaType = "image"
+	MediaVideo   MediaType = "video"
+	MediaGallery MediaType = "gallery"
+)
+
+// Media is an image, video or gallery attached to an article.
+type Media struct {
+	Type       MediaType   `json:"type" bson:"type"`
+	URL        string      `json:"url,omitempty" bson:"url,omitempty"` // The image, or the video stream
+	Width      int         `json:"width,omitempty" bson:"width,omitempty"`
+	Height     int         `json:"height,omitempty" bson:"height,omitempty"`
+	Caption    string      `json:"caption,omitempty" bson:"caption,omitempty"`
+	Credit     string      `json:"credit,omitempty" bson:"credit,omitempty"`
+	Duration   int         `json:"duration,omitempty" bson:"duration,omitempty"` // Video length in seconds
+	MIMEType   string      `json:"mime_type,omitempty" bson:"mime_type,omitempty"`
+	Thumbnails []Thumbnail `json:"thumbnails,omitempty" bson:"thumbnails,omitempty"` // Renditions and video posters
+	Items      []Media     `json:"items,omitempty" bson:"items,omitempty"`           // Gallery contents
+}
+
+// Thumbnail is a smaller rendition of an image or a video poster frame.
+type Thumbnail struct {
+	URL    string `json:"url" bson:"url"`
+	Width  int    `json:"width,omitempty" bson:"width,omitempty"`
+	Height int    `json:"height,omitempty" bson:"height,omitempty"`
+}
+
+// cloneMedia deep-copies media, including thumbnails and gallery items.
+func cloneMedia(media []Media) []Media {
+	if media == nil {
+		return nil
+	}
+	out := make([]Media, len(media))
+	for i, m := range media {
+		m.Thumbnails = slices.Clone(m.Thumbnails)
+		m.Items = cloneMedia(m.Items)
+		out[i] = m
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// OverrideFields are an editor's corrections to a crawled article. Nil fields
+// keep the crawled value; list fields replace the crawled list.
+type OverrideFields struct {
+	Title         *string         `json:"title,omitempty" bson:"title,omitempty"`
+	Description   *string         `json:"description,omitempty" bson:"description,omitempty"`
+	Summary       *string         `json:"summary,omitempty" bson:"summary,omitempty"`
+	ImageURL      *string         `json:"image_url,omitempty" bson:"image_url,omitempty"`
+	Tags          *[]Tag          `json:"tags,omitempty" bson:"tags,omitempty"`
+	CanonicalTags *[]CanonicalTag `json:"canonical_tags,omitempty" bson:"canonical_tags,omitempty"`
+	Sport         *string         `json:"sport,omitempty" bson:"sport,omitempty"`
+	Category      *string         `json:"category,omitempty" bson:"category,omitempty"`
+}
+
+// IsZero reports whether no field is overridden.
+func (f OverrideFields) IsZero() bool {
+	return f == OverrideFields{}
+}
+
+// ArticleOverride holds the overridden fields of one article. It is stored
+// apart from the article, so re-crawls never overwrite it, and applied when
+// the article is read through the API or published.
+type ArticleOverride struct {
+	ArticleID string         `json:"article_id" bson:"_id"`
+	Fields    OverrideFields `json:"fields" bson:"fields"`
+	UpdatedBy string         `json:"updated_by,omitempty" bson:"updated_by,omitempty"`
+	UpdatedAt time.Time      `json:"updated_at" bson:"updated_at"`
+}
+
+// OverrideAction is what an audited override change did.
+type OverrideAction string
+
+const (
+	OverrideSet   OverrideAction = "set"
+	OverrideClear OverrideAction = "clear"
+)
+
+// OverrideAudit records one change to one overridden field.
+type OverrideAudit struct {
+	ID        string         `json:"id" bson:"_id"`
+	ArticleID string         `json:"article_id" bson:"article_id"`
+	Field     string         `json:"field" bson:"field"`
+	Action    OverrideAction `json:"action" bson:"action"`
+	// Old is the value in effect before, overridden or crawled, and New the
+	// overridden value; after a clear the crawled value is in effect.
+	Old    json.RawMessage `json:"old,omitempty" bson:"old,omitempty"`
+	New    json.RawMessage `json:"new,omitempty" bson:"new,omitempty"`
+	Editor string          `json:"editor,omitempty" bson:"editor,omitempty"`
+	Reason string          `json:"reason,omitempty" bson:"reason,omitempty"`
+	At     time.Time       `json:"at" bson:"at"`
+}
+
+// OverrideStore persists article overrides and their audit trail.
+type OverrideStore interface {
+	GetOverrides(ctx context.Context, articleIDs []string) (map[string]ArticleOverride, error)
+	// SaveOverride replaces the article's override, or deletes it when it
+	// overrides no field.
+	SaveOverride(ctx context.Context, override ArticleOverride) error
+	AppendOverrideAudit(ctx context.Context, entries []OverrideAudit) error
+	// ListOverrideAudit returns an article's override changes, newest first.
+	ListOverrideAudit(ctx context.Context, articleID string, limit int) ([]OverrideAudit, error)
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "context"
+
+// SecondarySource is another source's copy of a primary article's story.
+type SecondarySource struct {
+	ArticleID string `json:"article_id" bson:"article_id"`
+	Source    string `json:"source" bson:"source"`
+	Provider  string `json:"provider,omitempty" bson:"provider,omitempty"`
+	URL       string `json:"url,omitempty" bson:"url,omitempty"`
+}
+
+// PrecedenceStore persists which article is the primary one of a story.
+type PrecedenceStore interface {
+	// Unlink makes the articles primaries.
+	DuplicateLinks
+	// SetSecondaries replaces the secondary sources listed on a primary article.
+	SetSecondaries(ctx context.Context, id string, secondaries []SecondarySource) error
+	// Demote makes the articles secondaries of primaryID: their secondaries
+	// and embargo hold are cleared.
+	Demote(ctx context.Context, ids []string, primaryID string) error
+	// MoveURL moves url from one article to another, the primary article of
+	// its story, which was stored without it.
+	MoveURL(ctx context.Context, fromID, toID, url string) error
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RemovalStore finds and marks articles their publisher has removed.
+type RemovalStore interface {
+	// FindUnseen returns the provider's articles, not withdrawn, that no crawl
+	// listed since seenSince and that were published at or after publishedFrom.
+	FindUnseen(ctx context.Context, provider string, seenSince, publishedFrom time.Time) ([]Article, error)
+	// MarkWithdrawn sets withdrawn_at and clears the content hash, so an article
+	// listed again is treated as changed and republished.
+	MarkWithdrawn(ctx context.Context, ids []string, at time.Time) error
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ReviewStatus is where an article stands in the human review queue.
+type ReviewStatus string
+
+const (
+	ReviewPending  ReviewStatus = "pending_review"
+	ReviewApproved ReviewStatus = "approved"
+	ReviewRejected ReviewStatus = "rejected" // Never published
+)
+
+// ArticleReview is the human review of an article that matched a review rule.
+type ArticleReview struct {
+	Status      ReviewStatus `json:"status" bson:"status"`
+	Rules       []string     `json:"rules,omitempty" bson:"rules,omitempty"` // Review rules the article matched
+	RequestedAt time.Time    `json:"requested_at" bson:"requested_at"`
+	ReviewedAt  *time.Time   `json:"reviewed_at,omitempty" bson:"reviewed_at,omitempty"`
+	Reviewer    string       `json:"reviewer,omitempty" bson:"reviewer,omitempty"`
+	Note        string       `json:"note,omitempty" bson:"note,omitempty"`
+}
+
+// Withholds reports whether the review keeps the article's events from being
+// published.
+func (r *ArticleReview) Withholds() bool {
+	return r != nil && r.Status != ReviewApproved
+}
+
+// ReviewStore persists the review state of articles.
+type ReviewStore interface {
+	GetReviews(ctx context.Context, ids []string) (map[string]ArticleReview, error)
+	SetReview(ctx context.Context, id string, review ArticleReview) error
+	// FindPendingReview returns the articles awaiting review, oldest request first.
+	FindPendingReview(ctx context.Context, limit int) ([]Article, error)
+	// PendingReviewStats returns the number of articles awaiting review and
+	// when the oldest was queued.
+	PendingReviewStats(ctx context.Context) (count int64, oldest time.Time, err error)
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// FieldChange describes how one article field differs between two versions.
+// List fields report the items added and removed.
+type FieldChange struct {
+	Field   string   `json:"field" bson:"field"`
+	Added   []string `json:"added,omitempty" bson:"added,omitempty"`
+	Removed []string `json:"removed,omitempty" bson:"removed,omitempty"`
+}
+
+// ArticleRevision is a version of an article replaced by a re-crawl, with the
+// changes that replaced it.
+type ArticleRevision struct {
+	ID        string        `json:"id" bson:"_id"` // Article ID and the revision's content hash
+	ArticleID string        `json:"article_id" bson:"article_id"`
+	Article   Article       `json:"article" bson:"article"`
+	Changes   []FieldChange `json:"changes" bson:"changes"`
+	RevisedAt time.Time     `json:"revised_at" bson:"revised_at"` // When it was replaced
+}
+
+// ArticleLookup loads stored articles by ID.
+type ArticleLookup interface {
+	GetArticles(ctx context.Context, ids []string) (map[string]Article, error)
+}
+
+// RevisionStore persists article revisions.
+type RevisionStore interface {
+	SaveRevisions(ctx context.Context, revisions []ArticleRevision) error
+	// ListRevisions returns an article's revisions, newest first.
+	ListRevisions(ctx context.Context, articleID string, limit int) ([]ArticleRevision, error)
+}
@@ -0,0 +1,20 @@
+package domain
+
+// Author is an article byline.
+type Author struct {
+	Name string `json:"name" bson:"name"`
+	Role string `json:"role,omitempty" bson:"role,omitempty"` // e.g. "Chief cricket correspondent"
+}
+
+// Rights is what downstream systems need to display an article legally.
+type Rights struct {
+	CopyrightHolder string   `json:"copyright_holder,omitempty" bson:"copyright_holder,omitempty"`
+	License         string   `json:"license,omitempty" bson:"license,omitempty"`           // e.g. "all-rights-reserved", "CC-BY-4.0"
+	Attribution     string   `json:"attribution,omitempty" bson:"attribution,omitempty"`   // Text displayed with the article
+	Restrictions    []string `json:"restrictions,omitempty" bson:"restrictions,omitempty"` // Syndication restrictions, e.g. "no-syndication", "uk-only"
+}
+
+// IsZero reports whether no rights metadata is known.
+func (r Rights) IsZero() bool {
+	return r.CopyrightHolder == "" && r.License == "" && r.Attribution == "" && len(r.Restrictions) == 0
+}
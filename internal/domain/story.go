@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Story groups articles about the same event, e.g. one match, across sources.
+type Story struct {
+	ID               string             `json:"id" bson:"_id"`
+	Title            string             `json:"title" bson:"title"` // Title of the first article
+	ArticleIDs       []string           `json:"article_ids" bson:"article_ids"`
+	EntityIDs        []string           `json:"entity_ids" bson:"entity_ids"`
+	TagIDs           []string           `json:"tag_ids" bson:"tag_ids"`
+	Terms            map[string]float64 `json:"-" bson:"terms"` // Term weights of the story's text
+	FirstPublishedAt time.Time          `json:"first_published_at" bson:"first_published_at"`
+	LastPublishedAt  time.Time          `json:"last_published_at" bson:"last_published_at"`
+	UpdatedAt        time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// StoryQuery selects the stories a new article may belong to.
+type StoryQuery struct {
+	ArticleIDs []string  // Stories already containing one of these articles
+	EntityIDs  []string  // Stories sharing an entity...
+	TagIDs     []string  // ...or a tag
+	From, To   time.Time // Stories active within this period
+}
+
+// StoryStore persists story clusters.
+type StoryStore interface {
+	FindStories(ctx context.Context, query StoryQuery) ([]Story, error)
+	// SaveStory merges the story into the stored one: IDs are added to the
+	// existing sets, the publication period is widened and Terms replaced.
+	SaveStory(ctx context.Context, story *Story) error
+}
+
+// StoryUpdatedEvent announces that articles joined a story.
+type StoryUpdatedEvent struct {
+	StoryID       string    `json:"story_id"`
+	Title         string    `json:"title"`
+	ArticleIDs    []string  `json:"article_ids"`
+	AddedArticles []string  `json:"added_article_ids"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// StoryEventPublisher publishes story events.
+type StoryEventPublisher interface {
+	PublishStoryUpdated(ctx context.Context, event StoryUpdatedEvent) error
+}
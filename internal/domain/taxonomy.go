@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// CanonicalTag is a cross-source taxonomy entry that source tags map onto.
+type CanonicalTag struct {
+	ID    string `json:"id" bson:"_id"` // e.g. "england-men"
+	Label string `json:"label" bson:"label"`
+}
+
+// TagMatchType selects how a TagMapping pattern is compared to a source tag.
+type TagMatchType string
+
+const (
+	TagMatchExact TagMatchType = "exact" // Label equals the pattern, or the tag ID does
+	TagMatchAlias TagMatchType = "alias" // Label equals the pattern ignoring case and spacing
+	TagMatchRegex TagMatchType = "regex" // Label matches the regular expression
+)
+
+// AnySource makes a TagMapping apply to every source.
+const AnySource = "*"
+
+// TagMapping maps tags of one source (or AnySource) onto a canonical tag.
+type TagMapping struct {
+	ID          string       `json:"id" bson:"_id"`
+	Source      string       `json:"source" bson:"source"` // Article.Source, or AnySource
+	MatchType   TagMatchType `json:"match_type" bson:"match_type"`
+	Pattern     string       `json:"pattern" bson:"pattern"`
+	CanonicalID string       `json:"canonical_id" bson:"canonical_id"`
+	UpdatedAt   time.Time    `json:"updated_at" bson:"updated_at"`
+}
+
+// Validate checks the mapping is complete and its pattern usable.
+func (m *TagMapping) Validate() error {
+	if m.Source == "" {
+		return fmt.Errorf("source is required")
+	}
+	if m.Pattern == "" {
+		return fmt.Errorf("pattern is required")
+	}
+	if m.CanonicalID == "" {
+		return fmt.Errorf("canonical_id is required")
+	}
+	switch m.MatchType {
+	case TagMatchExact, TagMatchAlias:
+	case TagMatchRegex:
+		if _, err := regexp.Compile(m.Pattern); err != nil {
+			return fmt.Errorf("invalid regex pattern: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown match_type %q", m.MatchType)
+	}
+	return nil
+}
+
+// UnmappedTag is a source tag seen during ingestion that no mapping covered.
+type UnmappedTag struct {
+	Source      string    `json:"source" bson:"source"`
+	TagID       int       `json:"tag_id" bson:"tag_id"`
+	Label       string    `json:"label" bson:"label"`
+	Count       int64     `json:"count" bson:"count"`
+	FirstSeenAt time.Time `json:"first_seen_at" bson:"first_seen_at"`
+	LastSeenAt  time.Time `json:"last_seen_at" bson:"last_seen_at"`
+}
+
+// TaxonomyStore persists the canonical taxonomy, its mappings and the unmapped tags seen.
+type TaxonomyStore interface {
+	ListCanonicalTags(ctx context.Context) ([]CanonicalTag, error)
+	UpsertCanonicalTag(ctx context.Context, tag CanonicalTag) error
+	ListTagMappings(ctx context.Context) ([]TagMapping, error)
+	SaveTagMapping(ctx context.Context, mapping *TagMapping) error // Assigns an ID when empty
+	DeleteTagMapping(ctx context.Context, id string) error
+	RecordUnmappedTags(ctx context.Context, tags []UnmappedTag) error // Adds Count, extends LastSeenAt
+	ListUnmappedTags(ctx context.Context, since time.Time, limit int) ([]UnmappedTag, error)
+}
+
+var (
+	// ErrNotFound is returned by stores when the requested record does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidInput wraps errors caused by a malformed request rather than a failure.
+	ErrInvalidInput = errors.New("invalid input")
+)
@@ -10,7 +10,13 @@ type PageInfo struct {
 	NumEntries int `json:"numEntries"`
 }
 
-// Transformer defines the interface for parsing and transforming raw data into Articles.
-type Transformer interface {
-	Transform(reader io.Reader) ([]Article, *PageInfo, error)
+// PageTransformer parses one page of a feed into items of type T.
+type PageTransformer[T any] interface {
+	Transform(reader io.Reader) ([]T, *PageInfo, error)
 }
+
+// Transformer defines the interface for parsing and transforming raw data into Articles.
+type Transformer = PageTransformer[Article]
+
+// MatchTransformer parses a page of a fixtures and results feed into Matches.
+type MatchTransformer = PageTransformer[Match]
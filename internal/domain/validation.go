@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Violation describes a single validation rule an article failed.
+type Violation struct {
+	Rule    string `json:"rule" bson:"rule"`
+	Message string `json:"message" bson:"message"`
+}
+
+// QuarantinedArticle is an article rejected by validation, kept aside with the
+// reasons it failed instead of being persisted or published.
+type QuarantinedArticle struct {
+	ID            string      `json:"id" bson:"_id"` // Same as Article.ID
+	Provider      string      `json:"provider" bson:"provider"`
+	Article       Article     `json:"article" bson:"article"`
+	Violations    []Violation `json:"violations" bson:"violations"`
+	QuarantinedAt time.Time   `json:"quarantined_at" bson:"quarantined_at"`
+}
+
+// QuarantineWriter stores articles that failed validation.
+type QuarantineWriter interface {
+	Quarantine(ctx context.Context, items []QuarantinedArticle) error
+}
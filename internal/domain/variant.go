@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+// Variant is one language version of an article.
+type Variant struct {
+	ID       string `json:"id" bson:"_id"`
+	Language string `json:"language" bson:"language"`
+	URL      string `json:"url" bson:"url"`
+	Title    string `json:"title" bson:"title"`
+}
+
+// VariantStore finds the stored language versions of articles.
+type VariantStore interface {
+	// FindVariants returns the articles of each variant group that are not
+	// withdrawn, keyed by group.
+	FindVariants(ctx context.Context, groups []string) (map[string][]Variant, error)
+}
@@ -0,0 +1,174 @@
+// Package classifier labels articles with a sport and a content category using
+// weighted keyword, regex, tag and source rules.
+package classifier
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+type compiledRule struct {
+	rule     domain.ClassificationRule
+	keywords []string // Lower-cased words joined by single spaces
+	regex    *regexp.Regexp
+	tags     []string // Lower-cased
+}
+
+// Classifier scores each value of a field by the summed weight of the rules
+// that fired for it. The best value reaching the minimum score wins; ties go
+// to the value whose rule comes first.
+type Classifier struct {
+	rules    []compiledRule
+	minScore float64
+}
+
+// New compiles the rules. Invalid or duplicate rules are rejected.
+func New(rules []domain.ClassificationRule, minScore float64) (*Classifier, error) {
+	if minScore <= 0 {
+		return nil, fmt.Errorf("minimum classification score must be positive, got %v", minScore)
+	}
+	c := &Classifier{minScore: minScore}
+	ids := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		if err := r.Validate(); err != nil {
+			return nil, fmt.Errorf("classification rule %s: %w", r.ID, err)
+		}
+		if ids[r.ID] {
+			return nil, fmt.Errorf("classification rule %s: duplicate id", r.ID)
+		}
+		ids[r.ID] = true
+
+		cr := compiledRule{rule: r}
+		for _, k := range r.Keywords {
+			if phrase := strings.Join(textproc.LowerWords(k), " "); phrase != "" {
+				cr.keywords = append(cr.keywords, phrase)
+			}
+		}
+		if r.Regex != "" {
+			cr.regex = regexp.MustCompile(r.Regex)
+		}
+		for _, t := range r.Tags {
+			cr.tags = append(cr.tags, strings.ToLower(strings.TrimSpace(t)))
+		}
+		c.rules = append(c.rules, cr)
+	}
+	return c, nil
+}
+
+// Len returns the number of rules.
+func (c *Classifier) Len() int {
+	return len(c.rules)
+}
+
+// Classify evaluates every rule against the article and returns the winning
+// sport and category together with the scores and the rules that fired.
+func (c *Classifier) Classify(article *domain.Article) domain.Classification {
+	body := article.BodyText
+	if body == "" {
+		body = textproc.ToText(article.Body)
+	}
+	text := article.Title + "\n" + article.Description + "\n" + body
+	words := " " + strings.Join(textproc.LowerWords(text), " ") + " "
+
+	tags := make(map[string]bool)
+	for _, t := range article.Tags {
+		tags[strings.ToLower(t.Label)] = true
+	}
+	for _, t := range article.CanonicalTags {
+		tags[strings.ToLower(t.ID)] = true
+		tags[strings.ToLower(t.Label)] = true
+	}
+
+	result := domain.Classification{Scores: map[domain.ClassificationField]map[string]float64{
+		domain.ClassifySport:    {},
+		domain.ClassifyCategory: {},
+	}}
+	var order []string // field/value in the order their first rule fired
+	for _, cr := range c.rules {
+		matched, ok := cr.match(article, text, words, tags)
+		if !ok {
+			continue
+		}
+		r := cr.rule
+		scores := result.Scores[r.Field]
+		if _, seen := scores[r.Value]; !seen {
+			order = append(order, string(r.Field)+"/"+r.Value)
+		}
+		scores[r.Value] += r.Weight
+		result.Hits = append(result.Hits, domain.RuleHit{RuleID: r.ID, Field: r.Field, Value: r.Value, Weight: r.Weight, Matched: matched})
+	}
+
+	best := map[domain.ClassificationField]float64{}
+	for _, key := range order {
+		field, value, _ := strings.Cut(key, "/")
+		f := domain.ClassificationField(field)
+		score := result.Scores[f][value]
+		if top, ok := best[f]; score < c.minScore || (ok && score <= top) {
+			continue
+		}
+		best[f] = score
+		switch f {
+		case domain.ClassifySport:
+			result.Sport = value
+		case domain.ClassifyCategory:
+			result.Category = value
+		}
+	}
+	return result
+}
+
+// match reports whether every condition of the rule holds and describes what matched.
+func (cr *compiledRule) match(article *domain.Article, text, words string, tags map[string]bool) ([]string, bool) {
+	var matched []string
+	if len(cr.rule.Sources) > 0 {
+		hit := ""
+		for _, s := range cr.rule.Sources {
+			if s == article.Source || s == article.Provider {
+				hit = s
+				break
+			}
+		}
+		if hit == "" {
+			return nil, false
+		}
+		matched = append(matched, "source:"+hit)
+	}
+	if len(cr.tags) > 0 {
+		hit := ""
+		for _, t := range cr.tags {
+			if tags[t] {
+				hit = t
+				break
+			}
+		}
+		if hit == "" {
+			return nil, false
+		}
+		matched = append(matched, "tag:"+hit)
+	}
+	if len(cr.keywords) > 0 {
+		hit := ""
+		for _, k := range cr.keywords {
+			if strings.Contains(words, " "+k+" ") {
+				hit = k
+				break
+			}
+		}
+		if hit == "" {
+			return nil, false
+		}
+		matched = append(matched, "keyword:"+hit)
+	}
+	if cr.regex != nil {
+		loc := cr.regex.FindStringIndex(text)
+		if loc == nil {
+			return nil, false
+		}
+		matched = append(matched, "regex:"+text[loc[0]:loc[1]])
+	}
+	return matched, true
+}
@@ -0,0 +1,79 @@
+package classifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifier_Classify(t *testing.T) {
+	rules := []domain.ClassificationRule{
+		{ID: "cricket-source", Field: domain.ClassifySport, Value: "cricket", Weight: 2, Sources: []string{"ecb-pulselive"}},
+		{ID: "cricket-terms", Field: domain.ClassifySport, Value: "cricket", Weight: 1, Keywords: []string{"wicket", "run out"}},
+		{ID: "football-terms", Field: domain.ClassifySport, Value: "football", Weight: 1, Keywords: []string{"penalty"}},
+		{ID: "not-football", Field: domain.ClassifySport, Value: "football", Weight: -1, Tags: []string{"cricket"}},
+		{ID: "injury", Field: domain.ClassifyCategory, Value: "injury", Weight: 1, Keywords: []string{"ruled out"}},
+		{ID: "report", Field: domain.ClassifyCategory, Value: "match_report", Weight: 1, Regex: `(?i)\bbeat\b`},
+		{ID: "report-from-wire", Field: domain.ClassifyCategory, Value: "match_report", Weight: 1, Regex: `(?i)\bbeat\b`, Sources: []string{"wire"}},
+	}
+	c, err := New(rules, 1)
+	require.NoError(t, err)
+
+	t.Run("sums the weights of fired rules", func(t *testing.T) {
+		article := &domain.Article{
+			Provider: "ecb-pulselive",
+			Title:    "Archer ruled out",
+			Body:     "<p>Archer was ruled out after a <b>run-out</b> attempt. The wicket was a penalty to lose.</p>",
+			Tags:     []domain.Tag{{Label: "Cricket"}},
+		}
+		result := c.Classify(article)
+
+		assert.Equal(t, "cricket", result.Sport)
+		assert.Equal(t, "injury", result.Category)
+		assert.Equal(t, 3.0, result.Scores[domain.ClassifySport]["cricket"])
+		assert.Equal(t, 0.0, result.Scores[domain.ClassifySport]["football"], "penalty is outweighed by the cricket tag")
+
+		var fired []string
+		for _, h := range result.Hits {
+			fired = append(fired, h.RuleID)
+		}
+		assert.Equal(t, []string{"cricket-source", "cricket-terms", "football-terms", "not-football", "injury"}, fired)
+		assert.Equal(t, []string{"keyword:wicket"}, result.Hits[1].Matched)
+	})
+
+	t.Run("requires every condition and the minimum score", func(t *testing.T) {
+		result := c.Classify(&domain.Article{Source: "blog", Title: "England beat Australia"})
+
+		assert.Empty(t, result.Sport)
+		assert.Equal(t, "match_report", result.Category)
+		require.Len(t, result.Hits, 1, "the wire-only rule does not fire")
+		assert.Equal(t, []string{"regex:beat"}, result.Hits[0].Matched)
+	})
+
+	t.Run("keywords match whole words", func(t *testing.T) {
+		result := c.Classify(&domain.Article{Title: "Wickets galore"})
+		assert.Empty(t, result.Hits)
+	})
+}
+
+func TestNew_InvalidRules(t *testing.T) {
+	_, err := New([]domain.ClassificationRule{{ID: "a", Field: "league", Value: "x", Weight: 1, Keywords: []string{"x"}}}, 1)
+	assert.Error(t, err)
+
+	_, err = New([]domain.ClassificationRule{{ID: "a", Field: domain.ClassifySport, Value: "x", Weight: 1, Regex: "("}}, 1)
+	assert.Error(t, err)
+
+	rule := domain.ClassificationRule{ID: "a", Field: domain.ClassifySport, Value: "x", Weight: 1, Keywords: []string{"x"}}
+	_, err = New([]domain.ClassificationRule{rule, rule}, 1)
+	assert.Error(t, err, "duplicate ids")
+}
+
+func TestFileSource_ShippedRules(t *testing.T) {
+	rules, err := NewFileSource("../../../config/classification.json").LoadRules(context.Background())
+	require.NoError(t, err)
+	_, err = New(rules, 1)
+	require.NoError(t, err)
+}
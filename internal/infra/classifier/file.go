@@ -0,0 +1,32 @@
+package classifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// FileSource loads classification rules from a JSON array of rules.
+type FileSource struct {
+	path string
+}
+
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+func (s *FileSource) LoadRules(_ context.Context) ([]domain.ClassificationRule, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read classification rules %s: %w", s.path, err)
+	}
+
+	var rules []domain.ClassificationRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to decode classification rules %s: %w", s.path, err)
+	}
+	return rules, nil
+}
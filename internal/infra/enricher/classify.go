@@ -0,0 +1,73 @@
+package enricher
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/classifier"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+)
+
+const ClassifyName = "classify"
+
+// ClassifierEnricher sets the article's Sport and Category from the
+// classification rules. It runs after the taxonomy enricher so rules can match
+// canonical tags. The rules can be reloaded while the enricher is in use.
+type ClassifierEnricher struct {
+	source     domain.ClassificationRuleSource
+	minScore   float64
+	classifier atomic.Pointer[classifier.Classifier]
+}
+
+// NewClassifierEnricher loads the rules from source.
+func NewClassifierEnricher(ctx context.Context, source domain.ClassificationRuleSource, minScore float64) (*ClassifierEnricher, error) {
+	e := &ClassifierEnricher{source: source, minScore: minScore}
+	if err := e.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *ClassifierEnricher) Name() string {
+	return ClassifyName
+}
+
+// Reload recompiles the rules from the source. On error the previous rules
+// stay in use.
+func (e *ClassifierEnricher) Reload(ctx context.Context) error {
+	rules, err := e.source.LoadRules(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load classification rules: %w", err)
+	}
+	c, err := classifier.New(rules, e.minScore)
+	if err != nil {
+		return fmt.Errorf("invalid classification rules: %w", err)
+	}
+	e.classifier.Store(c)
+	metrics.ClassificationRules.Set(float64(c.Len()))
+	return nil
+}
+
+// Classify returns the classification of the article without changing it.
+func (e *ClassifierEnricher) Classify(article *domain.Article) domain.Classification {
+	return e.classifier.Load().Classify(article)
+}
+
+func (e *ClassifierEnricher) Enrich(_ context.Context, article *domain.Article) error {
+	result := e.Classify(article)
+	article.Sport = result.Sport
+	article.Category = result.Category
+
+	for _, hit := range result.Hits {
+		metrics.ClassificationRuleHits.WithLabelValues(hit.RuleID).Inc()
+	}
+	if result.Sport == "" {
+		metrics.ArticlesUnclassified.WithLabelValues(article.Source, string(domain.ClassifySport)).Inc()
+	}
+	if result.Category == "" {
+		metrics.ArticlesUnclassified.WithLabelValues(article.Source, string(domain.ClassifyCategory)).Inc()
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package enricher
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/gazetteer"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+const EntitiesName = "entities"
+
+// EntityEnricher attaches gazetteer entities mentioned in the title, description
+// and body. The gazetteer can be reloaded while the enricher is in use.
+type EntityEnricher struct {
+	source  domain.GazetteerSource
+	matcher atomic.Pointer[gazetteer.Matcher]
+}
+
+// NewEntityEnricher loads the gazetteer from source.
+func NewEntityEnricher(ctx context.Context, source domain.GazetteerSource) (*EntityEnricher, error) {
+	e := &EntityEnricher{source: source}
+	if err := e.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *EntityEnricher) Name() string {
+	return EntitiesName
+}
+
+// Reload rebuilds the matcher from the gazetteer source. On error the previous
+// matcher stays in use.
+func (e *EntityEnricher) Reload(ctx context.Context) error {
+	entities, err := e.source.LoadEntities(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load gazetteer: %w", err)
+	}
+	m, err := gazetteer.NewMatcher(entities)
+	if err != nil {
+		return fmt.Errorf("invalid gazetteer: %w", err)
+	}
+	e.matcher.Store(m)
+
+	for _, t := range []domain.EntityType{domain.EntityTeam, domain.EntityPlayer, domain.EntityCompetition, domain.EntityVenue} {
+		metrics.GazetteerEntities.WithLabelValues(string(t)).Set(float64(m.Counts()[t]))
+	}
+	return nil
+}
+
+func (e *EntityEnricher) Enrich(_ context.Context, article *domain.Article) error {
+	body := article.BodyText
+	if body == "" {
+		body = textproc.ToText(article.Body)
+	}
+
+	article.Entities = e.matcher.Load().Match(article.Title, article.Description, body)
+	for _, ref := range article.Entities {
+		metrics.EntitiesExtracted.WithLabelValues(article.Source, string(ref.Type)).Inc()
+	}
+	return nil
+}
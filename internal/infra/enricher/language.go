@@ -0,0 +1,34 @@
+package enricher
+
+import (
+	"context"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+const LanguageName = "language"
+
+// LanguageEnricher detects the language of articles whose feed and source do
+// not declare one, offline from their title and text.
+type LanguageEnricher struct{}
+
+func NewLanguageEnricher() *LanguageEnricher {
+	return &LanguageEnricher{}
+}
+
+func (e *LanguageEnricher) Name() string {
+	return LanguageName
+}
+
+func (e *LanguageEnricher) Enrich(_ context.Context, article *domain.Article) error {
+	if article.Language != "" {
+		return nil
+	}
+	text := article.BodyText
+	if text == "" {
+		text = textproc.ToText(article.Body)
+	}
+	article.Language = textproc.DetectLanguage(article.Title + "\n" + article.Description + "\n" + text)
+	return nil
+}
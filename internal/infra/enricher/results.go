@@ -0,0 +1,40 @@
+package enricher
+
+import (
+	"context"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+	"github.com/SportsNewsCrawler/internal/infra/results"
+)
+
+const ResultsName = "results"
+
+// matchReportCategory is the classifier category of articles reporting a result.
+const matchReportCategory = "match_report"
+
+// ResultsEnricher extracts the match result reported by an article. It runs
+// after the classifier: the sport selects the phrasing looked for, and
+// articles classified into another category than match reports are skipped,
+// as previews and features tend to recall old results.
+type ResultsEnricher struct{}
+
+func NewResultsEnricher() *ResultsEnricher {
+	return &ResultsEnricher{}
+}
+
+func (e *ResultsEnricher) Name() string {
+	return ResultsName
+}
+
+func (e *ResultsEnricher) Enrich(_ context.Context, article *domain.Article) error {
+	article.MatchResult = nil
+	if article.Category != "" && article.Category != matchReportCategory {
+		return nil
+	}
+	article.MatchResult = results.Extract(article)
+	if article.MatchResult != nil {
+		metrics.MatchResultsExtracted.WithLabelValues(article.Source, article.MatchResult.Sport).Inc()
+	}
+	return nil
+}
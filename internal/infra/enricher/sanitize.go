@@ -0,0 +1,45 @@
+// Package enricher contains the domain.Enricher implementations registered in
+// the ingestion enrichment chain.
+package enricher
+
+import (
+	"context"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+const SanitizeName = "sanitize"
+
+// DefaultWordsPerMinute is the reading speed used for reading-time estimates.
+const DefaultWordsPerMinute = 230
+
+// SanitizeEnricher cleans the HTML body against an allowlist policy and derives
+// its plain-text and Markdown forms, word count and reading time.
+type SanitizeEnricher struct {
+	policy         *textproc.Policy
+	wordsPerMinute int
+}
+
+func NewSanitizeEnricher(policy *textproc.Policy, wordsPerMinute int) *SanitizeEnricher {
+	if policy == nil {
+		policy = textproc.DefaultPolicy()
+	}
+	if wordsPerMinute <= 0 {
+		wordsPerMinute = DefaultWordsPerMinute
+	}
+	return &SanitizeEnricher{policy: policy, wordsPerMinute: wordsPerMinute}
+}
+
+func (e *SanitizeEnricher) Name() string {
+	return SanitizeName
+}
+
+func (e *SanitizeEnricher) Enrich(_ context.Context, article *domain.Article) error {
+	article.Body = e.policy.Sanitize(article.Body)
+	article.BodyText = textproc.ToText(article.Body)
+	article.BodyMarkdown = textproc.ToMarkdown(article.Body)
+	article.WordCount = textproc.WordCount(article.BodyText)
+	article.ReadingTimeMinutes = textproc.ReadingTimeMinutes(article.WordCount, e.wordsPerMinute)
+	return nil
+}
@@ -0,0 +1,42 @@
+package enricher
+
+import (
+	"context"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+const SummaryName = "summary"
+
+// SummaryEnricher fills the summary, description and keywords a source left
+// empty from the article text, with extractive TextRank: no external service.
+type SummaryEnricher struct {
+	sentences int // Sentences in a generated summary
+	keywords  int // Keywords extracted per article
+}
+
+func NewSummaryEnricher(sentences, keywords int) *SummaryEnricher {
+	return &SummaryEnricher{sentences: sentences, keywords: keywords}
+}
+
+func (e *SummaryEnricher) Name() string {
+	return SummaryName
+}
+
+func (e *SummaryEnricher) Enrich(_ context.Context, article *domain.Article) error {
+	text := article.BodyText
+	if text == "" {
+		text = textproc.ToText(article.Body)
+	}
+	if article.Summary == "" {
+		article.Summary = textproc.Summarize(text, e.sentences)
+	}
+	if article.Description == "" {
+		article.Description = textproc.Summarize(text, 1)
+	}
+	if len(article.Keywords) == 0 {
+		article.Keywords = textproc.Keywords(article.Title+"\n"+text, e.keywords)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package enricher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/metrics"
+	"github.com/SportsNewsCrawler/internal/infra/taxonomy"
+)
+
+const TaxonomyName = "taxonomy"
+
+// TaxonomyEnricher maps the article's source tags onto canonical tags. Tags no
+// mapping covers are buffered and written to the store on Refresh.
+type TaxonomyEnricher struct {
+	store  domain.TaxonomyStore
+	mapper atomic.Pointer[taxonomy.Mapper]
+
+	mu       sync.Mutex
+	unmapped map[string]*domain.UnmappedTag
+}
+
+// NewTaxonomyEnricher loads the taxonomy and mappings from store.
+func NewTaxonomyEnricher(ctx context.Context, store domain.TaxonomyStore) (*TaxonomyEnricher, error) {
+	e := &TaxonomyEnricher{
+		store:    store,
+		unmapped: make(map[string]*domain.UnmappedTag),
+	}
+	if err := e.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *TaxonomyEnricher) Name() string {
+	return TaxonomyName
+}
+
+// Reload rebuilds the mapper from the store. On error the previous mapper stays in use.
+func (e *TaxonomyEnricher) Reload(ctx context.Context) error {
+	tags, err := e.store.ListCanonicalTags(ctx)
+	if err != nil {
+		return err
+	}
+	mappings, err := e.store.ListTagMappings(ctx)
+	if err != nil {
+		return err
+	}
+	m, err := taxonomy.NewMapper(tags, mappings)
+	if err != nil {
+		return fmt.Errorf("invalid taxonomy: %w", err)
+	}
+	e.mapper.Store(m)
+	return nil
+}
+
+// Refresh flushes the buffered unmapped tags and reloads the mappings.
+func (e *TaxonomyEnricher) Refresh(ctx context.Context) error {
+	if err := e.Flush(ctx); err != nil {
+		return err
+	}
+	return e.Reload(ctx)
+}
+
+// Flush writes the unmapped tags seen since the last flush to the store.
+func (e *TaxonomyEnricher) Flush(ctx context.Context) error {
+	e.mu.Lock()
+	pending := make([]domain.UnmappedTag, 0, len(e.unmapped))
+	for _, t := range e.unmapped {
+		pending = append(pending, *t)
+	}
+	e.unmapped = make(map[string]*domain.UnmappedTag)
+	e.mu.Unlock()
+
+	if err := e.store.RecordUnmappedTags(ctx, pending); err != nil {
+		// Put them back so the next flush retries
+		e.mu.Lock()
+		for _, t := range pending {
+			e.merge(t)
+		}
+		e.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// MapTags maps tags with the mappings currently in use, without recording unmapped ones.
+func (e *TaxonomyEnricher) MapTags(source string, tags []domain.Tag) ([]domain.CanonicalTag, []domain.Tag) {
+	return e.mapper.Load().Map(source, tags)
+}
+
+func (e *TaxonomyEnricher) Enrich(_ context.Context, article *domain.Article) error {
+	canonical, unmapped := e.mapper.Load().Map(article.Source, article.Tags)
+	article.CanonicalTags = canonical
+
+	metrics.TagsMapped.WithLabelValues(article.Source, "mapped").Add(float64(len(article.Tags) - len(unmapped)))
+	if len(unmapped) == 0 {
+		return nil
+	}
+	metrics.TagsMapped.WithLabelValues(article.Source, "unmapped").Add(float64(len(unmapped)))
+
+	now := time.Now()
+	e.mu.Lock()
+	for _, tag := range unmapped {
+		e.merge(domain.UnmappedTag{Source: article.Source, TagID: tag.ID, Label: tag.Label, Count: 1, FirstSeenAt: now, LastSeenAt: now})
+	}
+	e.mu.Unlock()
+	return nil
+}
+
+// merge adds unmapped tag sightings to the buffer; the caller holds mu.
+func (e *TaxonomyEnricher) merge(seen domain.UnmappedTag) {
+	key := fmt.Sprintf("%s:%d:%s", seen.Source, seen.TagID, seen.Label)
+	t, ok := e.unmapped[key]
+	if !ok {
+		e.unmapped[key] = &seen
+		return
+	}
+	t.Count += seen.Count
+	if seen.LastSeenAt.After(t.LastSeenAt) {
+		t.LastSeenAt = seen.LastSeenAt
+	}
+	if seen.FirstSeenAt.Before(t.FirstSeenAt) {
+		t.FirstSeenAt = seen.FirstSeenAt
+	}
+}
@@ -14,6 +14,11 @@ func NewCMSMockGateway() *CMSMockGateway {
 }
 
 func (g *CMSMockGateway) SyncArticle(ctx context.Context, article *domain.Article) error {
-	slog.Info("CMS Sync", "article_id", article.ID, "title", article.Title, "source", article.Source, "published_at", article.PublishedAt)
+	slog.Info("CMS Sync", "article_id", article.ID, "title", article.Title, "source", article.Source, "published_at", article.PublishedAt, "media", len(article.Media))
+	return nil
+}
+
+func (g *CMSMockGateway) DeleteArticle(ctx context.Context, article *domain.Article) error {
+	slog.Info("CMS Delete", "article_id", article.ID, "source", article.Source, "withdrawn_at", article.WithdrawnAt)
 	return nil
 }
@@ -0,0 +1,32 @@
+package gazetteer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// FileSource loads the gazetteer from a JSON array of entities.
+type FileSource struct {
+	path string
+}
+
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+func (s *FileSource) LoadEntities(_ context.Context) ([]domain.Entity, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gazetteer file %s: %w", s.path, err)
+	}
+
+	var entities []domain.Entity
+	if err := json.Unmarshal(data, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode gazetteer file %s: %w", s.path, err)
+	}
+	return entities, nil
+}
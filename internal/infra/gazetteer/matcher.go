@@ -0,0 +1,163 @@
+// Package gazetteer loads the locally maintained list of sports entities and
+// finds their mentions in article text.
+package gazetteer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+// pattern is one alias of an entity, tokenized.
+type pattern struct {
+	tokens   []string // Lower-cased, or as written when caseSensitive
+	entityID string
+	// Single-word aliases match case-sensitively so that surnames such as
+	// "Root" or "Wood" do not match the common words.
+	caseSensitive bool
+}
+
+// Matcher finds gazetteer entities in text by longest alias match.
+type Matcher struct {
+	patterns map[string][]pattern // Keyed by lower-cased first token
+	entities map[string]domain.Entity
+}
+
+// NewMatcher indexes the entities' names and aliases.
+func NewMatcher(entities []domain.Entity) (*Matcher, error) {
+	m := &Matcher{
+		patterns: make(map[string][]pattern),
+		entities: make(map[string]domain.Entity, len(entities)),
+	}
+	for _, e := range entities {
+		if e.ID == "" || e.Name == "" {
+			return nil, fmt.Errorf("gazetteer entity requires id and name: %+v", e)
+		}
+		switch e.Type {
+		case domain.EntityTeam, domain.EntityPlayer, domain.EntityCompetition, domain.EntityVenue:
+		default:
+			return nil, fmt.Errorf("gazetteer entity %s: unknown type %q", e.ID, e.Type)
+		}
+		if _, exists := m.entities[e.ID]; exists {
+			return nil, fmt.Errorf("duplicate gazetteer entity: %s", e.ID)
+		}
+		m.entities[e.ID] = e
+
+		seen := make(map[string]bool)
+		for _, alias := range append([]string{e.Name}, e.Aliases...) {
+			tokens := tokenize(alias)
+			if len(tokens) == 0 || seen[strings.Join(tokens, " ")] {
+				continue
+			}
+			seen[strings.Join(tokens, " ")] = true
+
+			p := pattern{entityID: e.ID, caseSensitive: len(tokens) == 1}
+			if p.caseSensitive {
+				p.tokens = tokens
+			} else {
+				p.tokens = lower(tokens)
+			}
+			key := strings.ToLower(tokens[0])
+			m.patterns[key] = append(m.patterns[key], p)
+		}
+	}
+
+	// Longest alias first so "England Women" wins over "England"
+	for key := range m.patterns {
+		sort.SliceStable(m.patterns[key], func(i, j int) bool {
+			return len(m.patterns[key][i].tokens) > len(m.patterns[key][j].tokens)
+		})
+	}
+	return m, nil
+}
+
+// Match returns the entities mentioned in the texts, most mentioned first.
+func (m *Matcher) Match(texts ...string) []domain.EntityRef {
+	counts := make(map[string]int)
+	for _, text := range texts {
+		tokens := tokenize(text)
+		lowered := lower(tokens)
+		for i := 0; i < len(tokens); {
+			if n, id := m.longestMatch(tokens, lowered, i); n > 0 {
+				counts[id]++
+				i += n
+				continue
+			}
+			i++
+		}
+	}
+
+	refs := make([]domain.EntityRef, 0, len(counts))
+	for id, n := range counts {
+		e := m.entities[id]
+		refs = append(refs, domain.EntityRef{ID: e.ID, Type: e.Type, Name: e.Name, Mentions: n})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Mentions != refs[j].Mentions {
+			return refs[i].Mentions > refs[j].Mentions
+		}
+		return refs[i].ID < refs[j].ID
+	})
+	return refs
+}
+
+func (m *Matcher) longestMatch(tokens, lowered []string, i int) (int, string) {
+	for _, p := range m.patterns[lowered[i]] {
+		if i+len(p.tokens) > len(tokens) {
+			continue
+		}
+		candidate := lowered[i : i+len(p.tokens)]
+		if p.caseSensitive {
+			candidate = tokens[i : i+len(p.tokens)]
+		}
+		if equal(candidate, p.tokens) {
+			return len(p.tokens), p.entityID
+		}
+	}
+	return 0, ""
+}
+
+// Counts returns the number of entities per type.
+func (m *Matcher) Counts() map[domain.EntityType]int {
+	counts := make(map[domain.EntityType]int)
+	for _, e := range m.entities {
+		counts[e.Type]++
+	}
+	return counts
+}
+
+// tokenize splits text into words, dropping possessive suffixes so that
+// "England's" matches "England". Aliases go through the same function, so
+// "Lord's" still matches itself.
+func tokenize(text string) []string {
+	words := textproc.Words(strings.ReplaceAll(text, "’", "'"))
+	for i, w := range words {
+		if len(w) > 2 && (strings.HasSuffix(w, "'s") || strings.HasSuffix(w, "'S")) {
+			words[i] = w[:len(w)-2]
+		}
+	}
+	return words
+}
+
+func lower(tokens []string) []string {
+	out := make([]string, len(tokens))
+	for i, t := range tokens {
+		out[i] = strings.ToLower(t)
+	}
+	return out
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package gazetteer
+
+import (
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEntities = []domain.Entity{
+	{ID: "team:england-men", Type: domain.EntityTeam, Name: "England Men", Aliases: []string{"England"}},
+	{ID: "team:england-women", Type: domain.EntityTeam, Name: "England Women"},
+	{ID: "player:joe-root", Type: domain.EntityPlayer, Name: "Joe Root", Aliases: []string{"Root"}},
+	{ID: "competition:the-ashes", Type: domain.EntityCompetition, Name: "The Ashes", Aliases: []string{"Ashes"}},
+	{ID: "venue:lords", Type: domain.EntityVenue, Name: "Lord's"},
+}
+
+func TestMatcher_Match(t *testing.T) {
+	m, err := NewMatcher(testEntities)
+	require.NoError(t, err)
+
+	refs := m.Match(
+		"Root century puts England on top at Lord’s",
+		"England's Joe Root reached three figures in the second Ashes Test. England Women play next. "+
+			"The groundsman said the root of the problem was the drainage.",
+	)
+
+	got := make(map[string]int)
+	for _, r := range refs {
+		got[r.ID] = r.Mentions
+	}
+	assert.Equal(t, map[string]int{
+		"team:england-men":      2,
+		"team:england-women":    1,
+		"player:joe-root":       2,
+		"competition:the-ashes": 1,
+		"venue:lords":           1,
+	}, got)
+	assert.Equal(t, "Joe Root", refs[0].Name, "most mentioned first, ties by ID")
+}
+
+func TestMatcher_InvalidGazetteer(t *testing.T) {
+	_, err := NewMatcher([]domain.Entity{{ID: "x", Type: "stadium", Name: "X"}})
+	assert.Error(t, err)
+
+	_, err = NewMatcher([]domain.Entity{testEntities[0], testEntities[0]})
+	assert.Error(t, err)
+}
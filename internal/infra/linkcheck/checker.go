@@ -0,0 +1,44 @@
+// Package linkcheck checks whether article pages are still online.
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Checker requests pages with HEAD, falling back to GET for servers that do
+// not support it. Redirects are followed.
+type Checker struct {
+	client *http.Client
+}
+
+func New(timeout time.Duration) *Checker {
+	return &Checker{client: &http.Client{Timeout: timeout}}
+}
+
+// Status returns the status code the page finally answers with.
+func (c *Checker) Status(ctx context.Context, url string) (int, error) {
+	status, err := c.request(ctx, http.MethodHead, url)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		return c.request(ctx, http.MethodGet, url)
+	}
+	return status, err
+}
+
+func (c *Checker) request(ctx context.Context, method, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	if err := resp.Body.Close(); err != nil {
+		slog.Warn("Failed to close response body", "error", err)
+	}
+	return resp.StatusCode, nil
+}
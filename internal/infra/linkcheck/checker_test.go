@@ -0,0 +1,41 @@
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_Status(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/live", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusGone) })
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/missing", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	checker := New(5 * time.Second)
+	tests := map[string]int{
+		"/live":     http.StatusOK,
+		"/gone":     http.StatusGone,
+		"/moved":    http.StatusNotFound,
+		"/get-only": http.StatusOK,
+	}
+	for path, want := range tests {
+		status, err := checker.Status(context.Background(), server.URL+path)
+		require.NoError(t, err, path)
+		assert.Equal(t, want, status, path)
+	}
+}
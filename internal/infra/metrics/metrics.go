@@ -22,6 +22,86 @@ var (
 		[]string{"source"},
 	)
 
+	ArticlesQuarantined = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_quarantined_total",
+			Help: "Total number of validation rule violations that sent articles to quarantine",
+		},
+		[]string{"source", "rule"},
+	)
+
+	EnricherDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "enricher_duration_seconds",
+			Help:    "Duration of a single enricher invocation on one article",
+			Buckets: []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+		},
+		[]string{"enricher"},
+	)
+
+	EnricherErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "enricher_errors_total",
+			Help: "Total number of enricher failures by applied failure policy",
+		},
+		[]string{"source", "enricher", "policy"},
+	)
+
+	EntitiesExtracted = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "entities_extracted_total",
+			Help: "Total number of distinct gazetteer entities attached to articles",
+		},
+		[]string{"source", "type"},
+	)
+
+	GazetteerEntities = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gazetteer_entities",
+			Help: "Number of entities in the loaded gazetteer",
+		},
+		[]string{"type"},
+	)
+
+	ClassificationRules = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "classification_rules",
+			Help: "Number of loaded sport and category classification rules",
+		},
+	)
+
+	ClassificationRuleHits = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "classification_rule_hits_total",
+			Help: "Total number of times each classification rule fired",
+		},
+		[]string{"rule"},
+	)
+
+	ArticlesUnclassified = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_unclassified_total",
+			Help: "Total number of classified articles no value reached the minimum score for, by field (sport, category)",
+		},
+		[]string{"source", "field"},
+	)
+
+	MatchResultsExtracted = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "match_results_extracted_total",
+			Help: "Total number of match results extracted from article text",
+		},
+		[]string{"source", "sport"},
+	)
+
+	TagsMapped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tags_mapped_total",
+			Help: "Total number of source tags resolved against the canonical taxonomy",
+		},
+		[]string{"source", "result"},
+	)
+
 	ProviderFetchDuration = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "provider_fetch_duration_seconds",
@@ -31,6 +111,23 @@ var (
 		[]string{"source"},
 	)
 
+	CrawlRuns = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "crawl_runs_total",
+			Help: "Total number of provider crawl runs by outcome (success, error, timeout, truncated)",
+		},
+		[]string{"source", "status"},
+	)
+
+	CrawlDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "crawl_duration_seconds",
+			Help:    "Wall-clock duration of a full provider crawl run",
+			Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+		},
+		[]string{"source"},
+	)
+
 	WorkerActiveCount = promauto.NewGauge(
 		prometheus.GaugeOpts{
 			Name: "worker_active_count",
@@ -118,4 +215,168 @@ var (
 		},
 		[]string{"source"},
 	)
+
+	ArticlesNearDuplicate = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_near_duplicate_total",
+			Help: "Total number of new or changed articles kept as secondaries of another article of their story",
+		},
+		[]string{"source"},
+	)
+
+	StoryAssignments = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "story_assignments_total",
+			Help: "Total number of articles assigned to a story, by whether the story was created or joined",
+		},
+		[]string{"result"},
+	)
+
+	StoryEventErrors = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "story_event_errors_total",
+			Help: "Total number of story-updated events that failed to publish",
+		},
+	)
+
+	ArticlesURLConflicts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_url_conflicts_total",
+			Help: "Total number of articles skipped because their canonical URL is stored under another ID",
+		},
+		[]string{"source"},
+	)
+
+	HashesMigrated = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "content_hashes_migrated_total",
+			Help: "Total number of unchanged articles whose stored hash was migrated to the current hasher",
+		},
+		[]string{"source"},
+	)
+
+	ArticlesWithdrawn = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_withdrawn_total",
+			Help: "Total number of articles withdrawn after disappearing from their feed",
+		},
+		[]string{"source"},
+	)
+
+	RemovalChecks = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "removal_checks_total",
+			Help: "Total number of pages of articles missing from their feed rechecked, by result (gone, online, error)",
+		},
+		[]string{"source", "result"},
+	)
+
+	RemovalSweepsSkipped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "removal_sweeps_skipped_total",
+			Help: "Total number of crawls after which too many articles were missing to withdraw them",
+		},
+		[]string{"source"},
+	)
+
+	ArticlesEmbargoed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_embargoed_total",
+			Help: "Total number of new or changed articles whose event was withheld until their embargo ends",
+		},
+		[]string{"source"},
+	)
+
+	EmbargoPending = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "embargo_pending_articles",
+			Help: "Number of articles whose event is waiting for their embargo to end",
+		},
+	)
+
+	EmbargoReleases = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "embargo_releases_total",
+			Help: "Total number of embargoed articles released, by trigger (scheduled, manual)",
+		},
+		[]string{"trigger"},
+	)
+
+	MatchesIngested = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "matches_ingested_total",
+			Help: "Total number of fixtures and results crawled, by status (new, changed, unchanged)",
+		},
+		[]string{"source", "status"},
+	)
+
+	ArticlesMatchLinked = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_match_linked_total",
+			Help: "Total number of new or changed articles linked to a match, by how (feed, inferred)",
+		},
+		[]string{"source", "how"},
+	)
+
+	ArticlesFiltered = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_filtered_total",
+			Help: "Total number of articles dropped by a source's content filter, by rule",
+		},
+		[]string{"source", "rule"},
+	)
+
+	ArticlesHeldForReview = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "articles_held_for_review_total",
+			Help: "Total number of new or changed articles whose event was withheld for human review, by matching rule",
+		},
+		[]string{"source", "rule"},
+	)
+
+	ReviewPending = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "review_pending_articles",
+			Help: "Number of articles awaiting human review",
+		},
+	)
+
+	ReviewOldestAge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "review_oldest_pending_age_seconds",
+			Help: "Time the oldest article awaiting human review has waited",
+		},
+	)
+
+	ReviewDecisions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "review_decisions_total",
+			Help: "Total number of reviewed articles, by decision (approved, rejected)",
+		},
+		[]string{"decision"},
+	)
+
+	LifecycleTransitions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "article_lifecycle_transitions_total",
+			Help: "Total number of article lifecycle transitions, by previous and new state",
+		},
+		[]string{"from", "to"},
+	)
+
+	LifecycleTransitionsRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "article_lifecycle_transitions_rejected_total",
+			Help: "Total number of article lifecycle transitions refused as not allowed from the current state",
+		},
+		[]string{"from", "to"},
+	)
+
+	PrecedenceTakeovers = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "source_precedence_takeovers_total",
+			Help: "Total number of stories whose primary article was replaced by a higher ranked source's copy, by that source",
+		},
+		[]string{"source"},
+	)
 )
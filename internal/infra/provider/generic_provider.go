@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -15,16 +16,30 @@ import (
 	"github.com/sony/gobreaker"
 )
 
-type GenericProvider struct {
+// GenericProvider crawls a paginated HTTP feed with retries and a circuit
+// breaker, decoding each page into items of type T: articles, or the matches
+// of a fixtures feed.
+type GenericProvider[T any] struct {
 	name        string
 	url         string
 	client      *http.Client
-	transformer domain.Transformer
+	transformer domain.PageTransformer[T]
 	pagination  config.PaginationConfig
 	cb          *gobreaker.CircuitBreaker
 }
 
-func NewGenericProvider(name, url string, transformer domain.Transformer, pagination config.PaginationConfig) *GenericProvider {
+// NewGenericProvider creates a provider crawling an article feed.
+func NewGenericProvider(name, url string, transformer domain.Transformer, pagination config.PaginationConfig) *GenericProvider[domain.Article] {
+	return NewGenericFeed(name, url, transformer, pagination)
+}
+
+// NewGenericMatchProvider creates a provider crawling a fixtures and results feed.
+func NewGenericMatchProvider(name, url string, transformer domain.MatchTransformer, pagination config.PaginationConfig) *GenericProvider[domain.Match] {
+	return NewGenericFeed(name, url, transformer, pagination)
+}
+
+// NewGenericFeed creates a provider crawling a feed of items of type T.
+func NewGenericFeed[T any](name, url string, transformer domain.PageTransformer[T], pagination config.PaginationConfig) *GenericProvider[T] {
 	cbSettings := gobreaker.Settings{
 		Name:        name,
 		MaxRequests: 1,
@@ -34,6 +49,11 @@ func NewGenericProvider(name, url string, transformer domain.Transformer, pagina
 			// Trip if we have 3 consecutive failures
 			return counts.ConsecutiveFailures >= 3
 		},
+		IsSuccessful: func(err error) bool {
+			// Our own cancellation (shutdown or an exhausted crawl budget) says
+			// nothing about the provider's health and must not trip the breaker.
+			return err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+		},
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
 			slog.Warn("CircuitBreaker state changed", "name", name, "from", from, "to", to)
 			var stateVal float64
@@ -50,7 +70,7 @@ func NewGenericProvider(name, url string, transformer domain.Transformer, pagina
 		},
 	}
 
-	return &GenericProvider{
+	return &GenericProvider[T]{
 		name: name,
 		url:  url,
 		client: &http.Client{
@@ -62,13 +82,13 @@ func NewGenericProvider(name, url string, transformer domain.Transformer, pagina
 	}
 }
 
-func (p *GenericProvider) GetName() string {
+func (p *GenericProvider[T]) GetName() string {
 	return p.name
 }
 
 const maxSafetyPages = 1000
 
-func (p *GenericProvider) Crawl(ctx context.Context, handler func([]domain.Article) error) error {
+func (p *GenericProvider[T]) Crawl(ctx context.Context, handler func([]T) error) error {
 	slog.Debug("Starting streaming crawl", "provider", p.name)
 
 	if err := p.crawlLoop(ctx, handler); err != nil {
@@ -78,13 +98,19 @@ func (p *GenericProvider) Crawl(ctx context.Context, handler func([]domain.Artic
 	return nil
 }
 
-func (p *GenericProvider) crawlLoop(ctx context.Context, handler func([]domain.Article) error) error {
+func (p *GenericProvider[T]) crawlLoop(ctx context.Context, handler func([]T) error) error {
 	page := 0
 	numPages := -1 // Unknown initially
 	consecutiveErrors := 0
 	const maxConsecutiveErrors = 5
 
 	for page < maxSafetyPages {
+		// Stop between pages once the crawl is cancelled or its budget is spent
+		if err := ctx.Err(); err != nil {
+			slog.Warn("Crawl cancelled, stopping", "provider", p.name, "page", page, "error", err)
+			return err
+		}
+
 		// Stop if we know the total pages and have reached it
 		if numPages != -1 && page >= numPages {
 			slog.Debug("Reached total pages", "provider", p.name, "page", page, "total_pages", numPages)
@@ -95,19 +121,19 @@ func (p *GenericProvider) crawlLoop(ctx context.Context, handler func([]domain.A
 		pageURL := p.buildURLWithPage(page)
 
 		// Fetch single page
-		articles, pageInfo, err := p.fetchSinglePage(ctx, pageURL, page)
+		items, pageInfo, err := p.fetchSinglePage(ctx, pageURL, page)
 		if err != nil {
 			slog.Error("Error fetching page, stopping crawl", "provider", p.name, "page", page, "error", err)
 			return err
 		}
 
-		if len(articles) == 0 {
-			slog.Debug("No articles on page, stopping", "provider", p.name, "page", page)
+		if len(items) == 0 {
+			slog.Debug("No items on page, stopping", "provider", p.name, "page", page)
 			break
 		}
 
 		// Process batch immediately via handler
-		if err := handler(articles); err != nil {
+		if err := handler(items); err != nil {
 			slog.Error("Handler failed (continuing)", "provider", p.name, "page", page, "error", err)
 			consecutiveErrors++
 			if consecutiveErrors >= maxConsecutiveErrors {
@@ -118,7 +144,7 @@ func (p *GenericProvider) crawlLoop(ctx context.Context, handler func([]domain.A
 			slog.Info("Processed page",
 				"provider", p.name,
 				"page", page,
-				"articles_count", len(articles))
+				"items_count", len(items))
 		}
 
 		// Update numPages from metadata if available
@@ -130,14 +156,15 @@ func (p *GenericProvider) crawlLoop(ctx context.Context, handler func([]domain.A
 		page++
 	}
 
-	if page >= maxSafetyPages {
+	if page >= maxSafetyPages && (numPages == -1 || numPages > page) {
 		slog.Warn("Reached max safety pages limit", "provider", p.name, "max_pages", maxSafetyPages)
+		return fmt.Errorf("stopped after %d pages: %w", maxSafetyPages, domain.ErrCrawlTruncated)
 	}
 
 	return nil
 }
 
-func (p *GenericProvider) buildURLWithPage(page int) string {
+func (p *GenericProvider[T]) buildURLWithPage(page int) string {
 	reqURL := p.url
 	separator := "?"
 	if len(reqURL) > 0 && (reqURL[len(reqURL)-1:] == "?" || contains(reqURL, "?")) {
@@ -171,7 +198,7 @@ func (p *GenericProvider) buildURLWithPage(page int) string {
 	return fmt.Sprintf("%s%s%s", reqURL, separator, params)
 }
 
-func (p *GenericProvider) fetchSinglePage(ctx context.Context, url string, page int) ([]domain.Article, *domain.PageInfo, error) {
+func (p *GenericProvider[T]) fetchSinglePage(ctx context.Context, url string, page int) ([]T, *domain.PageInfo, error) {
 	// Execute Request with Retries and Circuit Breaker
 	body, err := p.executeRequest(ctx, url, page)
 	if err != nil {
@@ -184,17 +211,17 @@ func (p *GenericProvider) fetchSinglePage(ctx context.Context, url string, page
 	}()
 
 	// Transform
-	articles, pageInfo, err := p.transformer.Transform(body)
+	items, pageInfo, err := p.transformer.Transform(body)
 	if err != nil {
 		// Record parse error
 		metrics.ParseErrors.WithLabelValues(p.name).Inc()
-		return nil, nil, fmt.Errorf("failed to transform articles from %s: %w", p.name, err)
+		return nil, nil, fmt.Errorf("failed to transform page from %s: %w", p.name, err)
 	}
 
-	return articles, pageInfo, nil
+	return items, pageInfo, nil
 }
 
-func (p *GenericProvider) executeRequest(ctx context.Context, url string, page int) (io.ReadCloser, error) {
+func (p *GenericProvider[T]) executeRequest(ctx context.Context, url string, page int) (io.ReadCloser, error) {
 	maxRetries := 3
 	backoff := 500 * time.Millisecond
 
@@ -219,6 +246,10 @@ func (p *GenericProvider) executeRequest(ctx context.Context, url string, page i
 
 			resp, respErr := p.client.Do(req)
 			if respErr != nil {
+				if ctx.Err() != nil {
+					// Cancelled or out of crawl budget; retrying cannot succeed
+					return nil, ctx.Err()
+				}
 				slog.Warn("Request failed", "provider", p.name, "page", page, "error", respErr)
 				continue // Retry on network error
 			}
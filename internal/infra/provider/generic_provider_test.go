@@ -115,3 +115,49 @@ func TestGenericProvider_Crawl_CircuitBreaker_Abort(t *testing.T) {
 	assert.Contains(t, err.Error(), "too many consecutive handler errors")
 	assert.Equal(t, 5, consecutiveFailures, "Should stop after 5 failures")
 }
+
+func TestGenericProvider_Crawl_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Cancel during the last retry, after the backoff waits
+		if attempts++; attempts <= 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	provider := NewGenericProvider("test-provider", server.URL, new(MockTransformer), config.PaginationConfig{})
+
+	err := provider.Crawl(ctx, func(articles []domain.Article) error { return nil })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotContains(t, err.Error(), "max retries exceeded")
+}
+
+func TestGenericProvider_Crawl_Truncated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	mockTransformer := new(MockTransformer)
+	mockTransformer.On("Transform", mock.Anything).Return(
+		[]domain.Article{{ID: "x"}},
+		&domain.PageInfo{NumPages: maxSafetyPages + 1},
+		nil,
+	)
+	provider := NewGenericProvider("test-provider", server.URL, mockTransformer, config.PaginationConfig{})
+
+	pages := 0
+	err := provider.Crawl(context.Background(), func(articles []domain.Article) error {
+		pages++
+		return nil
+	})
+
+	assert.ErrorIs(t, err, domain.ErrCrawlTruncated)
+	assert.Equal(t, maxSafetyPages, pages)
+}
@@ -0,0 +1,37 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/segmentio/kafka-go"
+)
+
+// MatchEventProducer publishes matches, keyed by match ID so the updates of
+// one match stay in order.
+type MatchEventProducer struct {
+	producer *KafkaProducer
+}
+
+func NewMatchEventProducer(producer *KafkaProducer) *MatchEventProducer {
+	return &MatchEventProducer{producer: producer}
+}
+
+func (p *MatchEventProducer) PublishMatches(ctx context.Context, matches []domain.Match) error {
+	msgs := make([]kafka.Message, len(matches))
+	for i, m := range matches {
+		payload, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		msgs[i] = kafka.Message{Key: []byte(m.ID), Value: payload}
+	}
+
+	if err := p.producer.writer.WriteMessages(ctx, msgs...); err != nil {
+		slog.Error("Failed to write matches to kafka", "error", err, "count", len(msgs))
+		return err
+	}
+	return nil
+}
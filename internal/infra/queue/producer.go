@@ -67,6 +67,19 @@ func (p *KafkaProducer) PublishBatch(ctx context.Context, articles []domain.Arti
 	return nil
 }
 
+// PublishJSON publishes value as a JSON message with the given key.
+func (p *KafkaProducer) PublishJSON(ctx context.Context, key string, value any) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if err := p.writer.WriteMessages(ctx, kafka.Message{Key: []byte(key), Value: payload}); err != nil {
+		slog.Error("Failed to write to kafka", "error", err, "topic", p.writer.Topic)
+		return err
+	}
+	return nil
+}
+
 func (p *KafkaProducer) Close() error {
 	return p.writer.Close()
 }
@@ -0,0 +1,21 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// StoryEventProducer publishes story events, keyed by story ID so the updates
+// of one story stay in order.
+type StoryEventProducer struct {
+	producer *KafkaProducer
+}
+
+func NewStoryEventProducer(producer *KafkaProducer) *StoryEventProducer {
+	return &StoryEventProducer{producer: producer}
+}
+
+func (p *StoryEventProducer) PublishStoryUpdated(ctx context.Context, event domain.StoryUpdatedEvent) error {
+	return p.producer.PublishJSON(ctx, event.StoryID, event)
+}
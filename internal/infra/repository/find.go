@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// findAll decodes every document of coll matching filter into results.
+func findAll(ctx context.Context, coll *mongo.Collection, filter interface{}, opts *options.FindOptions, results interface{}) error {
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			slog.Warn("Failed to close cursor", "error", err)
+		}
+	}()
+	return cursor.All(ctx, results)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoFingerprintRepository is the near-duplicate fingerprint index.
+type MongoFingerprintRepository struct {
+	collection *mongo.Collection
+}
+
+// fingerprintDocument stores the SimHash as int64, BSON has no unsigned integers.
+type fingerprintDocument struct {
+	ArticleID   string    `bson:"_id"`
+	Source      string    `bson:"source"`
+	SimHash     int64     `bson:"simhash"`
+	Bands       []string  `bson:"bands"`
+	CanonicalID string    `bson:"canonical_id"`
+	PublishedAt time.Time `bson:"published_at"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+func NewMongoFingerprintRepository(client *mongo.Client, dbName, collectionName string) (*MongoFingerprintRepository, error) {
+	repo := &MongoFingerprintRepository{
+		collection: client.Database(dbName).Collection(collectionName),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create fingerprint indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoFingerprintRepository) createIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "bands", Value: 1},
+				{Key: "published_at", Value: -1},
+			},
+			Options: options.Index().SetName("bands_published_at_idx"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "canonical_id", Value: 1},
+			},
+			Options: options.Index().SetName("canonical_id_idx"),
+		},
+	}
+
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	_, err := r.collection.Indexes().CreateMany(ctx, models, opts)
+	return err
+}
+
+func (r *MongoFingerprintRepository) FindCandidates(ctx context.Context, bands []string, from, to time.Time) ([]domain.Fingerprint, error) {
+	filter := bson.M{
+		"bands":        bson.M{"$in": bands},
+		"published_at": bson.M{"$gte": from, "$lte": to},
+	}
+
+	var docs []fingerprintDocument
+	if err := findAll(ctx, r.collection, filter, options.Find(), &docs); err != nil {
+		return nil, fmt.Errorf("failed to find fingerprints: %w", err)
+	}
+
+	fingerprints := make([]domain.Fingerprint, 0, len(docs))
+	for _, d := range docs {
+		fingerprints = append(fingerprints, domain.Fingerprint{
+			ArticleID:   d.ArticleID,
+			Source:      d.Source,
+			SimHash:     uint64(d.SimHash),
+			Bands:       d.Bands,
+			CanonicalID: d.CanonicalID,
+			PublishedAt: d.PublishedAt,
+			CreatedAt:   d.CreatedAt,
+		})
+	}
+	return fingerprints, nil
+}
+
+func (r *MongoFingerprintRepository) SaveFingerprints(ctx context.Context, fingerprints []domain.Fingerprint) error {
+	if len(fingerprints) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(fingerprints))
+	for _, fp := range fingerprints {
+		doc := fingerprintDocument{
+			ArticleID:   fp.ArticleID,
+			Source:      fp.Source,
+			SimHash:     int64(fp.SimHash),
+			Bands:       fp.Bands,
+			CanonicalID: fp.CanonicalID,
+			PublishedAt: fp.PublishedAt,
+			CreatedAt:   fp.CreatedAt,
+		}
+		models = append(models, mongo.NewReplaceOneModel().SetFilter(bson.M{"_id": fp.ArticleID}).SetReplacement(doc).SetUpsert(true))
+	}
+
+	opts := options.BulkWrite().SetOrdered(false)
+	if _, err := r.collection.BulkWrite(ctx, models, opts); err != nil {
+		return fmt.Errorf("failed to save fingerprints: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoGazetteerRepository reads gazetteer entities maintained in MongoDB.
+type MongoGazetteerRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoGazetteerRepository(client *mongo.Client, dbName, collectionName string) (*MongoGazetteerRepository, error) {
+	repo := &MongoGazetteerRepository{
+		collection: client.Database(dbName).Collection(collectionName),
+	}
+
+	model := mongo.IndexModel{
+		Keys:    bson.D{{Key: "type", Value: 1}},
+		Options: options.Index().SetName("type_idx"),
+	}
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	if _, err := repo.collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{model}, opts); err != nil {
+		return nil, fmt.Errorf("failed to create gazetteer indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoGazetteerRepository) LoadEntities(ctx context.Context) ([]domain.Entity, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query gazetteer: %w", err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			slog.Warn("Failed to close cursor", "error", err)
+		}
+	}()
+
+	var entities []domain.Entity
+	if err := cursor.All(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to decode gazetteer: %w", err)
+	}
+	return entities, nil
+}
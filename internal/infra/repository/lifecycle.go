@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoLifecycleRepository keeps the lifecycle state on the article documents
+// and the transitions in a collection of their own.
+type MongoLifecycleRepository struct {
+	articles    *mongo.Collection
+	transitions *mongo.Collection
+}
+
+func NewMongoLifecycleRepository(client *mongo.Client, dbName, articlesColl, transitionsColl string) (*MongoLifecycleRepository, error) {
+	db := client.Database(dbName)
+	repo := &MongoLifecycleRepository{
+		articles:    db.Collection(articlesColl),
+		transitions: db.Collection(transitionsColl),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create lifecycle indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoLifecycleRepository) createIndexes(ctx context.Context) error {
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	state := mongo.IndexModel{
+		Keys:    bson.D{{Key: "lifecycle.state", Value: 1}, {Key: "lifecycle.changed_at", Value: -1}},
+		Options: options.Index().SetName("lifecycle_state_idx").SetSparse(true),
+	}
+	if _, err := r.articles.Indexes().CreateOne(ctx, state, opts); err != nil {
+		return err
+	}
+	transitions := mongo.IndexModel{
+		Keys:    bson.D{{Key: "article_id", Value: 1}, {Key: "at", Value: -1}},
+		Options: options.Index().SetName("article_id_at_idx"),
+	}
+	_, err := r.transitions.Indexes().CreateOne(ctx, transitions, opts)
+	return err
+}
+
+func (r *MongoLifecycleRepository) GetLifecycles(ctx context.Context, ids []string) (map[string]domain.ArticleLifecycle, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "lifecycle": 1})
+
+	var docs []struct {
+		ID        string                  `bson:"_id"`
+		Lifecycle domain.ArticleLifecycle `bson:"lifecycle"`
+	}
+	if err := findAll(ctx, r.articles, bson.M{"_id": bson.M{"$in": ids}}, opts, &docs); err != nil {
+		return nil, fmt.Errorf("failed to get lifecycles: %w", err)
+	}
+
+	results := make(map[string]domain.ArticleLifecycle, len(docs))
+	for _, d := range docs {
+		results[d.ID] = d.Lifecycle
+	}
+	return results, nil
+}
+
+func (r *MongoLifecycleRepository) ApplyTransitions(ctx context.Context, transitions []domain.LifecycleTransition) ([]domain.LifecycleTransition, error) {
+	var applied []domain.LifecycleTransition
+	for _, t := range transitions {
+		// Untracked articles have no lifecycle.state; nil matches it missing
+		var from interface{} = t.From
+		if t.From == "" {
+			from = nil
+		}
+		lifecycle := domain.ArticleLifecycle{State: t.To, ChangedAt: t.At, Error: t.Error}
+		res, err := r.articles.UpdateOne(ctx,
+			bson.M{"_id": t.ArticleID, "lifecycle.state": from},
+			bson.M{"$set": bson.M{"lifecycle": lifecycle}},
+		)
+		if err != nil {
+			return applied, fmt.Errorf("failed to update lifecycle of %s: %w", t.ArticleID, err)
+		}
+		if res.MatchedCount > 0 {
+			applied = append(applied, t)
+		}
+	}
+	if len(applied) == 0 {
+		return nil, nil
+	}
+
+	docs := make([]interface{}, len(applied))
+	for i, t := range applied {
+		docs[i] = t
+	}
+	if _, err := r.transitions.InsertMany(ctx, docs); err != nil {
+		return applied, fmt.Errorf("failed to record lifecycle transitions: %w", err)
+	}
+	return applied, nil
+}
+
+func (r *MongoLifecycleRepository) FindByLifecycleState(ctx context.Context, state domain.LifecycleState, limit int) ([]domain.Article, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "lifecycle.changed_at", Value: -1}}).SetLimit(int64(limit))
+
+	articles := []domain.Article{}
+	if err := findAll(ctx, r.articles, bson.M{"lifecycle.state": state}, opts, &articles); err != nil {
+		return nil, fmt.Errorf("failed to find articles by lifecycle state: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MongoLifecycleRepository) ListTransitions(ctx context.Context, articleID string, limit int) ([]domain.LifecycleTransition, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	transitions := []domain.LifecycleTransition{}
+	if err := findAll(ctx, r.transitions, bson.M{"article_id": articleID}, opts, &transitions); err != nil {
+		return nil, fmt.Errorf("failed to list lifecycle transitions: %w", err)
+	}
+	return transitions, nil
+}
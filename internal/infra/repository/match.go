@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoMatchRepository stores fixtures and results.
+type MongoMatchRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoMatchRepository(client *mongo.Client, dbName, collectionName string) (*MongoMatchRepository, error) {
+	repo := &MongoMatchRepository{
+		collection: client.Database(dbName).Collection(collectionName),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create match indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoMatchRepository) createIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "starts_at", Value: 1}},
+			Options: options.Index().SetName("starts_at_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "teams.name", Value: 1}, {Key: "starts_at", Value: 1}},
+			Options: options.Index().SetName("teams_name_starts_at_idx"),
+		},
+	}
+
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	_, err := r.collection.Indexes().CreateMany(ctx, models, opts)
+	return err
+}
+
+func (r *MongoMatchRepository) GetMatchHashes(ctx context.Context, ids []string) (map[string]string, error) {
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "content_hash": 1})
+
+	var docs []struct {
+		ID          string `bson:"_id"`
+		ContentHash string `bson:"content_hash"`
+	}
+	if err := findAll(ctx, r.collection, filter, opts, &docs); err != nil {
+		return nil, fmt.Errorf("failed to get match hashes: %w", err)
+	}
+
+	results := make(map[string]string, len(docs))
+	for _, d := range docs {
+		results[d.ID] = d.ContentHash
+	}
+	return results, nil
+}
+
+func (r *MongoMatchRepository) BulkUpsertMatches(ctx context.Context, matches []domain.Match) error {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(matches))
+	for _, m := range matches {
+		update := bson.M{"$set": m}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": m.ID}).SetUpdate(update).SetUpsert(true))
+	}
+
+	opts := options.BulkWrite().SetOrdered(false)
+	if _, err := r.collection.BulkWrite(ctx, models, opts); err != nil {
+		return fmt.Errorf("failed to bulk upsert matches: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoMatchRepository) GetMatch(ctx context.Context, id string) (*domain.Match, error) {
+	var match domain.Match
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&match)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match: %w", err)
+	}
+	return &match, nil
+}
+
+func (r *MongoMatchRepository) FindMatches(ctx context.Context, q domain.MatchQuery) ([]domain.Match, error) {
+	filter := bson.M{}
+	startsAt := bson.M{}
+	if !q.From.IsZero() {
+		startsAt["$gte"] = q.From
+	}
+	if !q.To.IsZero() {
+		startsAt["$lte"] = q.To
+	}
+	if len(startsAt) > 0 {
+		filter["starts_at"] = startsAt
+	}
+	if q.Team != "" {
+		filter["teams.name"] = bson.M{"$regex": "^" + regexp.QuoteMeta(q.Team) + "$", "$options": "i"}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "starts_at", Value: 1}})
+	if q.Limit > 0 {
+		opts.SetLimit(int64(q.Limit))
+	}
+
+	matches := []domain.Match{}
+	if err := findAll(ctx, r.collection, filter, opts, &matches); err != nil {
+		return nil, fmt.Errorf("failed to find matches: %w", err)
+	}
+	return matches, nil
+}
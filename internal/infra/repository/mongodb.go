@@ -46,11 +46,50 @@ func (r *MongoRepository) createIndexes(ctx context.Context) error {
 			},
 			Options: options.Index().SetName("external_id_idx"),
 		},
+		{
+			Keys: bson.D{
+				{Key: "provider", Value: 1},
+				{Key: "last_seen_at", Value: 1},
+			},
+			Options: options.Index().SetName("provider_last_seen_at_idx"),
+		},
+		{
+			Keys: bson.D{{Key: "embargo_until", Value: 1}},
+			Options: options.Index().SetName("embargo_held_idx").
+				SetPartialFilterExpression(bson.M{"embargo_held": true}),
+		},
+		{
+			Keys:    bson.D{{Key: "variant_group", Value: 1}},
+			Options: options.Index().SetName("variant_group_idx").SetSparse(true),
+		},
+		{
+			Keys: bson.D{{Key: "review.requested_at", Value: 1}},
+			Options: options.Index().SetName("review_pending_idx").
+				SetPartialFilterExpression(bson.M{"review.status": domain.ReviewPending}),
+		},
+		{
+			Keys:    bson.D{{Key: "match_ids", Value: 1}, {Key: "published_at", Value: -1}},
+			Options: options.Index().SetName("match_ids_published_at_idx").SetSparse(true),
+		},
 	}
 
 	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
-	_, err := r.collection.Indexes().CreateMany(ctx, models, opts)
-	return err
+	if _, err := r.collection.Indexes().CreateMany(ctx, models, opts); err != nil {
+		return err
+	}
+
+	// One record per canonical URL. Articles stored before canonicalization may
+	// already collide; that must not stop the service, the index is created
+	// once they are cleaned up.
+	urlIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "url", Value: 1}},
+		Options: options.Index().SetName("url_unique_idx").SetUnique(true).
+			SetPartialFilterExpression(bson.M{"url": bson.M{"$gt": ""}}),
+	}
+	if _, err := r.collection.Indexes().CreateOne(ctx, urlIndex, options.CreateIndexes().SetMaxTime(10*time.Second)); err != nil {
+		slog.Warn("Failed to create unique url index, duplicate urls are only prevented by the crawler", "error", err)
+	}
+	return nil
 }
 
 func (r *MongoRepository) Upsert(ctx context.Context, article *domain.Article) error {
@@ -127,3 +166,240 @@ func (r *MongoRepository) GetContentHashes(ctx context.Context, ids []string) (m
 	}
 	return results, cursor.Err()
 }
+
+func (r *MongoRepository) GetIDsByURL(ctx context.Context, urls []string) (map[string]string, error) {
+	filter := bson.M{"url": bson.M{"$in": urls}}
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "url": 1})
+
+	var docs []struct {
+		ID  string `bson:"_id"`
+		URL string `bson:"url"`
+	}
+	if err := findAll(ctx, r.collection, filter, opts, &docs); err != nil {
+		return nil, fmt.Errorf("failed to look up urls: %w", err)
+	}
+
+	results := make(map[string]string, len(docs))
+	for _, d := range docs {
+		results[d.URL] = d.ID
+	}
+	return results, nil
+}
+
+func (r *MongoRepository) GetArticles(ctx context.Context, ids []string) (map[string]domain.Article, error) {
+	var articles []domain.Article
+	if err := findAll(ctx, r.collection, bson.M{"_id": bson.M{"$in": ids}}, options.Find(), &articles); err != nil {
+		return nil, fmt.Errorf("failed to get articles: %w", err)
+	}
+
+	results := make(map[string]domain.Article, len(articles))
+	for _, a := range articles {
+		results[a.ID] = a
+	}
+	return results, nil
+}
+
+func (r *MongoRepository) FindUnseen(ctx context.Context, provider string, seenSince, publishedFrom time.Time) ([]domain.Article, error) {
+	filter := bson.M{
+		"provider":     provider,
+		"last_seen_at": bson.M{"$lt": seenSince},
+		"published_at": bson.M{"$gte": publishedFrom},
+		"withdrawn_at": nil,
+	}
+	var articles []domain.Article
+	if err := findAll(ctx, r.collection, filter, options.Find(), &articles); err != nil {
+		return nil, fmt.Errorf("failed to find unseen articles: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MongoRepository) MarkWithdrawn(ctx context.Context, ids []string, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	update := bson.M{"$set": bson.M{"withdrawn_at": at, "content_hash": ""}}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to mark articles withdrawn: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRepository) FindHeld(ctx context.Context, due time.Time, limit int) ([]domain.Article, error) {
+	// Articles under review are released once approved
+	filter := bson.M{
+		"embargo_held":  true,
+		"review.status": bson.M{"$nin": bson.A{domain.ReviewPending, domain.ReviewRejected}},
+	}
+	if !due.IsZero() {
+		// A lifted embargo is due too
+		filter["$or"] = bson.A{
+			bson.M{"embargo_until": bson.M{"$lte": due}},
+			bson.M{"embargo_until": nil},
+		}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "embargo_until", Value: 1}}).SetLimit(int64(limit))
+
+	var articles []domain.Article
+	if err := findAll(ctx, r.collection, filter, opts, &articles); err != nil {
+		return nil, fmt.Errorf("failed to find embargoed articles: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MongoRepository) CountHeld(ctx context.Context) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"embargo_held": true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count embargoed articles: %w", err)
+	}
+	return count, nil
+}
+
+func (r *MongoRepository) MarkReleased(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	if _, err := r.collection.UpdateMany(ctx, filter, bson.M{"$unset": bson.M{"embargo_held": ""}}); err != nil {
+		return fmt.Errorf("failed to mark embargoed articles released: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRepository) FindVariants(ctx context.Context, groups []string) (map[string][]domain.Variant, error) {
+	filter := bson.M{"variant_group": bson.M{"$in": groups}, "withdrawn_at": nil}
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "language": 1, "url": 1, "title": 1, "variant_group": 1})
+
+	var docs []struct {
+		domain.Variant `bson:",inline"`
+		Group          string `bson:"variant_group"`
+	}
+	if err := findAll(ctx, r.collection, filter, opts, &docs); err != nil {
+		return nil, fmt.Errorf("failed to find variants: %w", err)
+	}
+
+	results := make(map[string][]domain.Variant)
+	for _, d := range docs {
+		results[d.Group] = append(results[d.Group], d.Variant)
+	}
+	return results, nil
+}
+
+func (r *MongoRepository) FindArticlesByMatch(ctx context.Context, matchID string, limit int) ([]domain.Article, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "published_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	articles := []domain.Article{}
+	if err := findAll(ctx, r.collection, bson.M{"match_ids": matchID, "withdrawn_at": nil}, opts, &articles); err != nil {
+		return nil, fmt.Errorf("failed to find match articles: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MongoRepository) GetReviews(ctx context.Context, ids []string) (map[string]domain.ArticleReview, error) {
+	filter := bson.M{"_id": bson.M{"$in": ids}, "review": bson.M{"$exists": true}}
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "review": 1})
+
+	var docs []struct {
+		ID     string               `bson:"_id"`
+		Review domain.ArticleReview `bson:"review"`
+	}
+	if err := findAll(ctx, r.collection, filter, opts, &docs); err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+
+	results := make(map[string]domain.ArticleReview, len(docs))
+	for _, d := range docs {
+		results[d.ID] = d.Review
+	}
+	return results, nil
+}
+
+func (r *MongoRepository) SetReview(ctx context.Context, id string, review domain.ArticleReview) error {
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"review": review}})
+	if err != nil {
+		return fmt.Errorf("failed to set review: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoRepository) FindPendingReview(ctx context.Context, limit int) ([]domain.Article, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "review.requested_at", Value: 1}}).SetLimit(int64(limit))
+
+	articles := []domain.Article{}
+	if err := findAll(ctx, r.collection, bson.M{"review.status": domain.ReviewPending}, opts, &articles); err != nil {
+		return nil, fmt.Errorf("failed to find articles pending review: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MongoRepository) PendingReviewStats(ctx context.Context) (int64, time.Time, error) {
+	filter := bson.M{"review.status": domain.ReviewPending}
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to count articles pending review: %w", err)
+	}
+	if count == 0 {
+		return 0, time.Time{}, nil
+	}
+
+	var oldest struct {
+		Review domain.ArticleReview `bson:"review"`
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "review.requested_at", Value: 1}}).SetProjection(bson.M{"review": 1})
+	if err := r.collection.FindOne(ctx, filter, opts).Decode(&oldest); err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to find oldest article pending review: %w", err)
+	}
+	return count, oldest.Review.RequestedAt, nil
+}
+
+func (r *MongoRepository) SetSecondaries(ctx context.Context, id string, secondaries []domain.SecondarySource) error {
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"secondaries": secondaries}})
+	if err != nil {
+		return fmt.Errorf("failed to set secondaries: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoRepository) Demote(ctx context.Context, ids []string, primaryID string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	update := bson.M{
+		"$set":   bson.M{"duplicate_of": primaryID, "embargo_held": false},
+		"$unset": bson.M{"secondaries": ""},
+	}
+	if _, err := r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, update); err != nil {
+		return fmt.Errorf("failed to demote articles: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRepository) Unlink(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bson.M{"$unset": bson.M{"duplicate_of": ""}}); err != nil {
+		return fmt.Errorf("failed to unlink duplicate articles: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRepository) MoveURL(ctx context.Context, fromID, toID, url string) error {
+	// The unique url index allows one owner at a time
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": fromID, "url": url}, bson.M{"$unset": bson.M{"url": ""}}); err != nil {
+		return fmt.Errorf("failed to release url: %w", err)
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": toID}, bson.M{"$set": bson.M{"url": url}}); err != nil {
+		return fmt.Errorf("failed to move url: %w", err)
+	}
+	return nil
+}
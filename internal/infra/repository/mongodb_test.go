@@ -85,4 +85,42 @@ func TestMongoRepository_Integration(t *testing.T) {
 		assert.Equal(t, "h2", hashes["b2"])
 		assert.Len(t, hashes, 2)
 	})
+
+	t.Run("Demote and Unlink", func(t *testing.T) {
+		articles := []domain.Article{
+			{ID: "p1", Source: "wire", Title: "Wire copy", URL: "http://test.com/p1"},
+			{ID: "p2", Source: "official", Title: "Official story", URL: "http://test.com/p2", DuplicateOf: "p1"},
+		}
+		require.NoError(t, repo.BulkUpsert(ctx, articles))
+
+		// The official article takes the story over
+		require.NoError(t, repo.Demote(ctx, []string{"p1"}, "p2"))
+		articles[1].DuplicateOf = ""
+		require.NoError(t, repo.BulkUpsert(ctx, articles[1:]))
+
+		stored, err := repo.GetArticles(ctx, []string{"p1", "p2"})
+		require.NoError(t, err)
+		assert.Equal(t, "p2", stored["p1"].DuplicateOf)
+		assert.Equal(t, "p1", stored["p2"].DuplicateOf, "upserts keep duplicate_of")
+
+		require.NoError(t, repo.Unlink(ctx, []string{"p2"}))
+		stored, err = repo.GetArticles(ctx, []string{"p1", "p2"})
+		require.NoError(t, err)
+		assert.Equal(t, "p2", stored["p1"].DuplicateOf)
+		assert.Empty(t, stored["p2"].DuplicateOf)
+	})
+
+	t.Run("MoveURL", func(t *testing.T) {
+		const url = "http://test.com/story"
+		articles := []domain.Article{
+			{ID: "m1", Source: "wire", Title: "Wire copy", URL: url},
+			{ID: "m2", Source: "official", Title: "Official story"},
+		}
+		require.NoError(t, repo.BulkUpsert(ctx, articles))
+
+		require.NoError(t, repo.MoveURL(ctx, "m1", "m2", url))
+		owners, err := repo.GetIDsByURL(ctx, []string{url})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{url: "m2"}, owners)
+	})
 }
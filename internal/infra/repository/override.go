@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoOverrideRepository stores editorial overrides apart from the articles,
+// with the audit trail of their changes.
+type MongoOverrideRepository struct {
+	overrides *mongo.Collection
+	audit     *mongo.Collection
+}
+
+func NewMongoOverrideRepository(client *mongo.Client, dbName, overridesColl, auditColl string) (*MongoOverrideRepository, error) {
+	db := client.Database(dbName)
+	repo := &MongoOverrideRepository{
+		overrides: db.Collection(overridesColl),
+		audit:     db.Collection(auditColl),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create override indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoOverrideRepository) createIndexes(ctx context.Context) error {
+	model := mongo.IndexModel{
+		Keys:    bson.D{{Key: "article_id", Value: 1}, {Key: "at", Value: -1}},
+		Options: options.Index().SetName("article_id_at_idx"),
+	}
+	_, err := r.audit.Indexes().CreateOne(ctx, model, options.CreateIndexes().SetMaxTime(10*time.Second))
+	return err
+}
+
+func (r *MongoOverrideRepository) GetOverrides(ctx context.Context, articleIDs []string) (map[string]domain.ArticleOverride, error) {
+	var overrides []domain.ArticleOverride
+	if err := findAll(ctx, r.overrides, bson.M{"_id": bson.M{"$in": articleIDs}}, options.Find(), &overrides); err != nil {
+		return nil, fmt.Errorf("failed to get overrides: %w", err)
+	}
+
+	results := make(map[string]domain.ArticleOverride, len(overrides))
+	for _, o := range overrides {
+		results[o.ArticleID] = o
+	}
+	return results, nil
+}
+
+func (r *MongoOverrideRepository) SaveOverride(ctx context.Context, override domain.ArticleOverride) error {
+	filter := bson.M{"_id": override.ArticleID}
+	if override.Fields.IsZero() {
+		if _, err := r.overrides.DeleteOne(ctx, filter); err != nil {
+			return fmt.Errorf("failed to delete override: %w", err)
+		}
+		return nil
+	}
+	if _, err := r.overrides.ReplaceOne(ctx, filter, override, options.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to save override: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoOverrideRepository) AppendOverrideAudit(ctx context.Context, entries []domain.OverrideAudit) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, len(entries))
+	for i, e := range entries {
+		docs[i] = e
+	}
+	if _, err := r.audit.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("failed to append override audit: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoOverrideRepository) ListOverrideAudit(ctx context.Context, articleID string, limit int) ([]domain.OverrideAudit, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	entries := []domain.OverrideAudit{}
+	if err := findAll(ctx, r.audit, bson.M{"article_id": articleID}, opts, &entries); err != nil {
+		return nil, fmt.Errorf("failed to list override audit: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoQuarantineRepository stores articles rejected by validation.
+type MongoQuarantineRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoQuarantineRepository(client *mongo.Client, dbName, collectionName string) (*MongoQuarantineRepository, error) {
+	repo := &MongoQuarantineRepository{
+		collection: client.Database(dbName).Collection(collectionName),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create quarantine indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoQuarantineRepository) createIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "provider", Value: 1},
+				{Key: "quarantined_at", Value: -1},
+			},
+			Options: options.Index().SetName("provider_quarantined_at_idx"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "violations.rule", Value: 1},
+			},
+			Options: options.Index().SetName("violations_rule_idx"),
+		},
+	}
+
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	_, err := r.collection.Indexes().CreateMany(ctx, models, opts)
+	return err
+}
+
+// Quarantine upserts the rejected articles, keeping only the latest failure per article.
+func (r *MongoQuarantineRepository) Quarantine(ctx context.Context, items []domain.QuarantinedArticle) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(items))
+	for _, item := range items {
+		filter := bson.M{"_id": item.ID}
+		update := bson.M{"$set": item}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true))
+	}
+
+	opts := options.BulkWrite().SetOrdered(false)
+	if _, err := r.collection.BulkWrite(ctx, models, opts); err != nil {
+		return fmt.Errorf("failed to quarantine articles: %w", err)
+	}
+	return nil
+}
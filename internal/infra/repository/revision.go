@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoRevisionRepository stores the prior versions of updated articles.
+type MongoRevisionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRevisionRepository(client *mongo.Client, dbName, collectionName string) (*MongoRevisionRepository, error) {
+	repo := &MongoRevisionRepository{
+		collection: client.Database(dbName).Collection(collectionName),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create revision indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoRevisionRepository) createIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "article_id", Value: 1},
+				{Key: "revised_at", Value: -1},
+			},
+			Options: options.Index().SetName("article_id_revised_at_idx"),
+		},
+	}
+
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	_, err := r.collection.Indexes().CreateMany(ctx, models, opts)
+	return err
+}
+
+// SaveRevisions inserts the revisions; saving the same revision again is a no-op.
+func (r *MongoRevisionRepository) SaveRevisions(ctx context.Context, revisions []domain.ArticleRevision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(revisions))
+	for _, rev := range revisions {
+		update := bson.M{"$setOnInsert": rev}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": rev.ID}).SetUpdate(update).SetUpsert(true))
+	}
+
+	opts := options.BulkWrite().SetOrdered(false)
+	if _, err := r.collection.BulkWrite(ctx, models, opts); err != nil {
+		return fmt.Errorf("failed to save revisions: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRevisionRepository) ListRevisions(ctx context.Context, articleID string, limit int) ([]domain.ArticleRevision, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "revised_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	revisions := []domain.ArticleRevision{}
+	if err := findAll(ctx, r.collection, bson.M{"article_id": articleID}, opts, &revisions); err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	return revisions, nil
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxStoryCandidates bounds the stories loaded to cluster one batch.
+const maxStoryCandidates = 500
+
+// MongoStoryRepository stores story clusters.
+type MongoStoryRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoStoryRepository(client *mongo.Client, dbName, collectionName string) (*MongoStoryRepository, error) {
+	repo := &MongoStoryRepository{
+		collection: client.Database(dbName).Collection(collectionName),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create story indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoStoryRepository) createIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "article_ids", Value: 1}},
+			Options: options.Index().SetName("article_ids_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "entity_ids", Value: 1}, {Key: "last_published_at", Value: -1}},
+			Options: options.Index().SetName("entity_ids_last_published_at_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "tag_ids", Value: 1}, {Key: "last_published_at", Value: -1}},
+			Options: options.Index().SetName("tag_ids_last_published_at_idx"),
+		},
+	}
+
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	_, err := r.collection.Indexes().CreateMany(ctx, models, opts)
+	return err
+}
+
+// FindStories returns the stories containing one of the articles, plus the
+// stories active in the period that share an entity or tag with them.
+func (r *MongoStoryRepository) FindStories(ctx context.Context, q domain.StoryQuery) ([]domain.Story, error) {
+	related := bson.A{}
+	if len(q.EntityIDs) > 0 {
+		related = append(related, bson.M{"entity_ids": bson.M{"$in": q.EntityIDs}})
+	}
+	if len(q.TagIDs) > 0 {
+		related = append(related, bson.M{"tag_ids": bson.M{"$in": q.TagIDs}})
+	}
+
+	active := bson.M{
+		"first_published_at": bson.M{"$lte": q.To},
+		"last_published_at":  bson.M{"$gte": q.From},
+	}
+	if len(related) > 0 {
+		active["$or"] = related
+	}
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"article_ids": bson.M{"$in": q.ArticleIDs}},
+		active,
+	}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "last_published_at", Value: -1}}).
+		SetLimit(maxStoryCandidates)
+
+	var stories []domain.Story
+	if err := findAll(ctx, r.collection, filter, opts, &stories); err != nil {
+		return nil, fmt.Errorf("failed to find stories: %w", err)
+	}
+	return stories, nil
+}
+
+// SaveStory merges the story into the stored one so concurrent batches adding
+// articles to the same story do not overwrite each other's IDs.
+func (r *MongoStoryRepository) SaveStory(ctx context.Context, story *domain.Story) error {
+	update := bson.M{
+		"$setOnInsert": bson.M{"title": story.Title},
+		"$addToSet": bson.M{
+			"article_ids": bson.M{"$each": story.ArticleIDs},
+			"entity_ids":  bson.M{"$each": nonNil(story.EntityIDs)},
+			"tag_ids":     bson.M{"$each": nonNil(story.TagIDs)},
+		},
+		"$min": bson.M{"first_published_at": story.FirstPublishedAt},
+		"$max": bson.M{"last_published_at": story.LastPublishedAt},
+		"$set": bson.M{"terms": story.Terms, "updated_at": story.UpdatedAt},
+	}
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": story.ID}, update, opts); err != nil {
+		return fmt.Errorf("failed to save story: %w", err)
+	}
+	return nil
+}
+
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoTaxonomyRepository stores the canonical tag taxonomy, its per-source
+// mappings and the unmapped tags seen during ingestion.
+type MongoTaxonomyRepository struct {
+	tags     *mongo.Collection
+	mappings *mongo.Collection
+	unmapped *mongo.Collection
+}
+
+func NewMongoTaxonomyRepository(client *mongo.Client, dbName string) (*MongoTaxonomyRepository, error) {
+	db := client.Database(dbName)
+	repo := &MongoTaxonomyRepository{
+		tags:     db.Collection("taxonomy"),
+		mappings: db.Collection("tag_mappings"),
+		unmapped: db.Collection("unmapped_tags"),
+	}
+
+	if err := repo.createIndexes(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create taxonomy indexes: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (r *MongoTaxonomyRepository) createIndexes(ctx context.Context) error {
+	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
+	if _, err := r.mappings.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "source", Value: 1},
+				{Key: "match_type", Value: 1},
+				{Key: "pattern", Value: 1},
+			},
+			Options: options.Index().SetName("source_match_pattern_idx").SetUnique(true),
+		},
+	}, opts); err != nil {
+		return err
+	}
+
+	_, err := r.unmapped.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "last_seen_at", Value: -1}},
+			Options: options.Index().SetName("last_seen_at_idx"),
+		},
+	}, opts)
+	return err
+}
+
+func (r *MongoTaxonomyRepository) ListCanonicalTags(ctx context.Context) ([]domain.CanonicalTag, error) {
+	var tags []domain.CanonicalTag
+	if err := findAll(ctx, r.tags, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}), &tags); err != nil {
+		return nil, fmt.Errorf("failed to list canonical tags: %w", err)
+	}
+	return tags, nil
+}
+
+func (r *MongoTaxonomyRepository) UpsertCanonicalTag(ctx context.Context, tag domain.CanonicalTag) error {
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.tags.UpdateOne(ctx, bson.M{"_id": tag.ID}, bson.M{"$set": tag}, opts); err != nil {
+		return fmt.Errorf("failed to upsert canonical tag: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoTaxonomyRepository) ListTagMappings(ctx context.Context) ([]domain.TagMapping, error) {
+	var mappings []domain.TagMapping
+	if err := findAll(ctx, r.mappings, bson.M{}, options.Find().SetSort(bson.D{{Key: "updated_at", Value: 1}}), &mappings); err != nil {
+		return nil, fmt.Errorf("failed to list tag mappings: %w", err)
+	}
+	return mappings, nil
+}
+
+func (r *MongoTaxonomyRepository) SaveTagMapping(ctx context.Context, mapping *domain.TagMapping) error {
+	if mapping.ID == "" {
+		mapping.ID = primitive.NewObjectID().Hex()
+	}
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.mappings.UpdateOne(ctx, bson.M{"_id": mapping.ID}, bson.M{"$set": mapping}, opts); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%w: a mapping for this source, match type and pattern already exists", domain.ErrInvalidInput)
+		}
+		return fmt.Errorf("failed to save tag mapping: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoTaxonomyRepository) DeleteTagMapping(ctx context.Context, id string) error {
+	res, err := r.mappings.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return fmt.Errorf("failed to delete tag mapping: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *MongoTaxonomyRepository) RecordUnmappedTags(ctx context.Context, tags []domain.UnmappedTag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(tags))
+	for _, t := range tags {
+		filter := bson.M{"_id": t.Source + ":" + strconv.Itoa(t.TagID) + ":" + t.Label}
+		update := bson.M{
+			"$inc":         bson.M{"count": t.Count},
+			"$max":         bson.M{"last_seen_at": t.LastSeenAt},
+			"$min":         bson.M{"first_seen_at": t.FirstSeenAt},
+			"$setOnInsert": bson.M{"source": t.Source, "tag_id": t.TagID, "label": t.Label},
+		}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true))
+	}
+
+	if _, err := r.unmapped.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to record unmapped tags: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoTaxonomyRepository) ListUnmappedTags(ctx context.Context, since time.Time, limit int) ([]domain.UnmappedTag, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "count", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	var tags []domain.UnmappedTag
+	if err := findAll(ctx, r.unmapped, bson.M{"last_seen_at": bson.M{"$gte": since}}, opts, &tags); err != nil {
+		return nil, fmt.Errorf("failed to list unmapped tags: %w", err)
+	}
+	return tags, nil
+}
@@ -0,0 +1,190 @@
+// Package results extracts match results, such as "England 325-7 beat
+// Australia by 45 runs" or "Arsenal 2-1 Chelsea", from article text.
+package results
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/textproc"
+)
+
+// maxBodySentences is how far into the body a result is looked for. Match
+// reports state it early; later sentences tend to recall other matches.
+const maxBodySentences = 5
+
+// leadingWords are capitalized words that start a sentence but not a team name.
+var leadingWords = textproc.Words(`Monday Tuesday Wednesday Thursday Friday Saturday Sunday January February March
+April May June July August September October November December Yesterday Today Tonight Earlier Meanwhile`)
+
+// Extract returns the first match result found in the article's title,
+// description or opening body sentences, or nil. The sport selects the
+// phrasing looked for; an empty sport tries cricket, then football.
+func Extract(article *domain.Article) *domain.MatchResult {
+	sets := patternSets(article.Sport)
+	if len(sets) == 0 {
+		return nil
+	}
+
+	body := article.BodyText
+	if body == "" {
+		body = textproc.ToText(article.Body)
+	}
+	texts := []string{article.Title, article.Description}
+	sentences := textproc.Sentences(body)
+	if len(sentences) > maxBodySentences {
+		sentences = sentences[:maxBodySentences]
+	}
+	texts = append(texts, sentences...)
+
+	for _, text := range texts {
+		for _, set := range sets {
+			if result := set.extract(text); result != nil {
+				result.Competition = competition(article.Entities)
+				linkTeams(result, article.Entities)
+				return result
+			}
+		}
+	}
+	return nil
+}
+
+// extract returns the result of the first pattern matching text, or nil.
+func (s patternSet) extract(text string) *domain.MatchResult {
+	for _, p := range s.patterns {
+		match := p.re.FindStringSubmatch(text)
+		if match == nil {
+			continue
+		}
+		// Alternatives repeat group names; the one that matched is set
+		g := make(map[string]string)
+		for i, name := range p.re.SubexpNames() {
+			if name != "" && match[i] != "" {
+				g[name] = strings.TrimSpace(match[i])
+			}
+		}
+		a := domain.TeamScore{Team: trimTeam(g["a"]), Score: g["as"]}
+		b := domain.TeamScore{Team: trimTeam(g["b"]), Score: g["bs"]}
+		if a.Team == "" || (p.kind != winnerOnly && (b.Team == "" || a.Team == b.Team)) {
+			continue
+		}
+
+		result := &domain.MatchResult{Sport: s.sport, Text: text}
+		switch p.kind {
+		case winnerFirst:
+			setWinner(result, a, b)
+		case loserFirst:
+			setWinner(result, b, a)
+		case winnerOnly:
+			result.Outcome = domain.OutcomeWin
+			result.Winner = a.Team
+			result.Teams = []domain.TeamScore{a}
+		case drawn:
+			result.Outcome = domain.OutcomeDraw
+			result.Teams = []domain.TeamScore{a, b}
+		case tied:
+			result.Outcome = domain.OutcomeTie
+			result.Teams = []domain.TeamScore{a, b}
+		case scoreline:
+			as, _ := strconv.Atoi(a.Score)
+			bs, _ := strconv.Atoi(b.Score)
+			switch {
+			case as > bs:
+				setWinner(result, a, b)
+			case bs > as:
+				setWinner(result, b, a)
+			default:
+				result.Outcome = domain.OutcomeDraw
+				result.Teams = []domain.TeamScore{a, b}
+			}
+		}
+
+		if g["margin"] != "" {
+			result.Margin = g["margin"]
+			result.MarginValue = number(g["n"])
+			result.MarginUnit = strings.TrimSuffix(g["unit"], "s") + "s"
+		} else if s.unit != "" && result.Outcome == domain.OutcomeWin {
+			diff := number(result.Teams[0].Score) - number(result.Teams[1].Score)
+			result.MarginValue = diff
+			result.MarginUnit = s.unit
+			result.Margin = fmt.Sprintf("%d %s", diff, s.unit)
+			if diff == 1 {
+				result.Margin = "1 " + strings.TrimSuffix(s.unit, "s")
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func setWinner(result *domain.MatchResult, winner, loser domain.TeamScore) {
+	result.Outcome = domain.OutcomeWin
+	result.Winner = winner.Team
+	result.Teams = []domain.TeamScore{winner, loser}
+}
+
+// trimTeam drops the words a sentence may start with before the team name,
+// as in "On Sunday England beat...". Nothing is left of a date such as
+// "Sunday 12-13 July".
+func trimTeam(name string) string {
+	words := strings.Fields(name)
+	for len(words) > 0 && isLeadingWord(words[0]) {
+		words = words[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+func isLeadingWord(w string) bool {
+	if textproc.IsStopword(strings.ToLower(w)) {
+		return true
+	}
+	for _, l := range leadingWords {
+		if w == l {
+			return true
+		}
+	}
+	return false
+}
+
+// number parses a margin or score, spelled out up to ten; anything after the
+// leading number, such as the wickets in "325-7", is ignored.
+func number(s string) int {
+	for i, w := range strings.Split(numberWords, "|") {
+		if strings.EqualFold(s, w) {
+			return i + 1
+		}
+	}
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	n, _ := strconv.Atoi(s[:end])
+	return n
+}
+
+// competition returns the competition the article mentions most, or "".
+func competition(entities []domain.EntityRef) string {
+	name, mentions := "", 0
+	for _, e := range entities {
+		if e.Type == domain.EntityCompetition && e.Mentions > mentions {
+			name, mentions = e.Name, e.Mentions
+		}
+	}
+	return name
+}
+
+// linkTeams sets the gazetteer entity of each team the article mentions under
+// its name, e.g. "England" for "England Men".
+func linkTeams(result *domain.MatchResult, entities []domain.EntityRef) {
+	for i := range result.Teams {
+		t := &result.Teams[i]
+		for _, e := range entities {
+			if e.Type == domain.EntityTeam && (e.Name == t.Team || strings.HasPrefix(e.Name, t.Team+" ")) {
+				t.EntityID = e.ID
+				break
+			}
+		}
+	}
+}
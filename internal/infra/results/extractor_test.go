@@ -0,0 +1,144 @@
+package results
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/SportsNewsCrawler/internal/infra/transformer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pulseLiveReports are match reports as the ECB PulseLive content API returns them.
+const pulseLiveReports = `{
+  "pageInfo": {"page": 0, "numPages": 1, "pageSize": 10, "numEntries": 6},
+  "content": [
+    {
+      "id": 4101, "type": "text", "date": "2024-07-12T17:40:00Z",
+      "title": "England beat West Indies by an innings and 114 runs in Anderson's farewell Test",
+      "description": "Gus Atkinson takes 12 wickets on debut as England win inside three days at Lord's",
+      "body": "<p>England completed an emphatic victory on the third morning at Lord's.</p>"
+    },
+    {
+      "id": 4102, "type": "text", "date": "2024-09-19T21:10:00Z",
+      "title": "Head leads Australia to victory in first Metro Bank ODI",
+      "description": "Australia 315-3 (44 overs) beat England 315 (49.4 overs) by seven wickets at Trent Bridge",
+      "body": "<p>Travis Head struck an unbeaten 154 as Australia cruised home.</p>"
+    },
+    {
+      "id": 4103, "type": "text", "date": "2024-07-20T22:30:00Z",
+      "title": "Vitality Blast: Roses glory for Yorkshire",
+      "description": "",
+      "body": "<p>On Friday night Lancashire Lightning lost to Yorkshire Vikings by 21 runs at Headingley.</p><p>Dawid Malan top-scored with 68.</p>"
+    },
+    {
+      "id": 4104, "type": "text", "date": "2024-06-30T18:00:00Z",
+      "title": "Rain frustrates Somerset as Championship leaders held",
+      "description": "",
+      "body": "<p>No play was possible on the final day. Somerset and Surrey drew at Taunton, with both sides taking 10 points.</p>"
+    },
+    {
+      "id": 4105, "type": "text", "date": "2024-08-10T19:15:00Z",
+      "title": "Oval Invincibles win by 6 wickets to go top of The Hundred",
+      "description": "",
+      "body": ""
+    },
+    {
+      "id": 4106, "type": "text", "date": "2024-08-11T09:00:00Z",
+      "title": "Ben Stokes: I'm ready to return for the Sri Lanka series",
+      "description": "England captain on recovering from hamstring injury",
+      "body": "<p>Stokes tore his hamstring playing for Northern Superchargers in The Hundred on Sunday 11-18 August.</p>"
+    }
+  ]
+}`
+
+func TestExtract_PulseLiveReports(t *testing.T) {
+	articles, _, err := transformer.NewPulseLiveTransformer().Transform(strings.NewReader(pulseLiveReports))
+	require.NoError(t, err)
+	require.Len(t, articles, 6)
+	for i := range articles {
+		articles[i].Sport = "cricket"
+	}
+
+	t.Run("innings victory in the title", func(t *testing.T) {
+		result := Extract(&articles[0])
+		require.NotNil(t, result)
+		assert.Equal(t, domain.OutcomeWin, result.Outcome)
+		assert.Equal(t, "England", result.Winner)
+		assert.Equal(t, []domain.TeamScore{{Team: "England"}, {Team: "West Indies"}}, result.Teams)
+		assert.Equal(t, "an innings and 114 runs", result.Margin)
+		assert.Equal(t, 114, result.MarginValue)
+		assert.Equal(t, "runs", result.MarginUnit)
+	})
+
+	t.Run("scores and overs in the description", func(t *testing.T) {
+		result := Extract(&articles[1])
+		require.NotNil(t, result)
+		assert.Equal(t, []domain.TeamScore{{Team: "Australia", Score: "315-3"}, {Team: "England", Score: "315"}}, result.Teams)
+		assert.Equal(t, "seven wickets", result.Margin)
+		assert.Equal(t, 7, result.MarginValue)
+		assert.Equal(t, "wickets", result.MarginUnit)
+	})
+
+	t.Run("loser first in the body", func(t *testing.T) {
+		articles[2].Entities = []domain.EntityRef{
+			{ID: "team:yorkshire", Type: domain.EntityTeam, Name: "Yorkshire Vikings", Mentions: 1},
+			{ID: "competition:vitality-blast", Type: domain.EntityCompetition, Name: "Vitality Blast", Mentions: 1},
+		}
+		result := Extract(&articles[2])
+		require.NotNil(t, result)
+		assert.Equal(t, "Yorkshire Vikings", result.Winner)
+		assert.Equal(t, "Lancashire Lightning", result.Teams[1].Team, "leading words are not part of the team")
+		assert.Equal(t, "team:yorkshire", result.Teams[0].EntityID)
+		assert.Equal(t, "Vitality Blast", result.Competition)
+		assert.Equal(t, "21 runs", result.Margin)
+	})
+
+	t.Run("draw", func(t *testing.T) {
+		result := Extract(&articles[3])
+		require.NotNil(t, result)
+		assert.Equal(t, domain.OutcomeDraw, result.Outcome)
+		assert.Empty(t, result.Winner)
+		assert.Equal(t, []domain.TeamScore{{Team: "Somerset"}, {Team: "Surrey"}}, result.Teams)
+	})
+
+	t.Run("winner only", func(t *testing.T) {
+		result := Extract(&articles[4])
+		require.NotNil(t, result)
+		assert.Equal(t, "Oval Invincibles", result.Winner)
+		assert.Len(t, result.Teams, 1)
+		assert.Equal(t, "6 wickets", result.Margin)
+	})
+
+	t.Run("no result in a news story", func(t *testing.T) {
+		articles[5].Sport = ""
+		assert.Nil(t, Extract(&articles[5]), "dates are not scorelines")
+	})
+}
+
+func TestExtract_Scorelines(t *testing.T) {
+	result := Extract(&domain.Article{Sport: "football", Title: "Arsenal 2-1 Chelsea: Saka seals derby win"})
+	require.NotNil(t, result)
+	assert.Equal(t, "Arsenal", result.Winner)
+	assert.Equal(t, []domain.TeamScore{{Team: "Arsenal", Score: "2"}, {Team: "Chelsea", Score: "1"}}, result.Teams)
+	assert.Equal(t, "1 goal", result.Margin)
+
+	result = Extract(&domain.Article{Sport: "football", Title: "Everton lost 3-0 to Manchester City at Goodison"})
+	require.NotNil(t, result)
+	assert.Equal(t, "Manchester City", result.Winner)
+	assert.Equal(t, []domain.TeamScore{{Team: "Manchester City", Score: "3"}, {Team: "Everton", Score: "0"}}, result.Teams)
+
+	result = Extract(&domain.Article{Sport: "football", Title: "Spurs 1-1 Newcastle"})
+	require.NotNil(t, result)
+	assert.Equal(t, domain.OutcomeDraw, result.Outcome)
+	assert.Empty(t, result.Margin)
+
+	result = Extract(&domain.Article{Sport: "rugby", Title: "Six Nations: England beat Wales 24-10 at Twickenham"})
+	require.NotNil(t, result)
+	assert.Equal(t, "England", result.Winner)
+	assert.Equal(t, 14, result.MarginValue)
+	assert.Equal(t, "points", result.MarginUnit)
+
+	assert.Nil(t, Extract(&domain.Article{Sport: "tennis", Title: "Alcaraz 3-1 Djokovic"}), "no pattern set for the sport")
+}
@@ -0,0 +1,110 @@
+package results
+
+import (
+	"regexp"
+	"strings"
+)
+
+// patternKind says how a pattern's groups map onto the result.
+type patternKind int
+
+const (
+	winnerFirst patternKind = iota // Team a beat team b
+	loserFirst                     // Team a lost to team b
+	winnerOnly                     // Team a won, the opponent is not named
+	scoreline                      // Team a score-score team b; the scores decide
+	drawn                          // Teams a and b drew
+	tied                           // Teams a and b tied
+)
+
+// resultPattern is one way a result is phrased. Its named groups are a and b
+// for the teams, as and bs for their scores, and margin, n and unit for the
+// winning margin.
+type resultPattern struct {
+	kind patternKind
+	re   *regexp.Regexp
+}
+
+// patternSet is the result phrasing of one sport.
+type patternSet struct {
+	sport    string
+	unit     string // Unit of margins computed from the scores, e.g. "goals"
+	patterns []resultPattern
+}
+
+const (
+	// team is up to five capitalized words, allowing "of" and "&" between them
+	team = `[A-Z][\p{L}'’.-]*(?:(?:\s+(?:of|&))?\s+[A-Z][\p{L}'’.-]*){0,4}`
+	// numberWords spell out small margins, as in "by six wickets"
+	numberWords = `one|two|three|four|five|six|seven|eight|nine|ten`
+
+	winVerbs  = `beat|beats|defeated|defeat|defeats|thrashed|thrash|crushed|overcame|edged past|edged out|edged|saw off|downed|stunned|hammered|routed|outclassed|eased past|swept past|(?:won|win|wins) against`
+	loseVerbs = `lost to|lose to|loses to|fell to|(?:were|was) beaten by|slipped to defeat against`
+	winAlone  = `won|win|wins|triumphed|cruised to victory|sealed victory|clinched victory|sealed a win|clinched a win`
+	versus    = `(?:and|v|vs\.?)`
+
+	// cricketScore is an innings total such as "325-7", "280", "401/5 dec" or
+	// "250 & 198-4", optionally followed by the overs faced in brackets
+	cricketScore  = `\d{1,3}(?:[-/]\d{1,2})?(?:\s*(?:dec|d|all out))?(?:\s*&\s*\d{1,3}(?:[-/]\d{1,2})?(?:\s*(?:dec|d))?)?`
+	cricketMargin = `(?P<margin>(?:an innings and\s+)?(?P<n>\d+|` + numberWords + `)\s+(?P<unit>runs?|wickets?))`
+)
+
+// expand compiles a pattern, replacing TEAM_A, TEAM_B, SCORE_A and SCORE_B.
+func expand(kind patternKind, pattern, score string) resultPattern {
+	r := strings.NewReplacer(
+		"TEAM_A", `(?P<a>`+team+`)`,
+		"TEAM_B", `(?P<b>`+team+`)`,
+		"SCORE_A", `(?P<as>`+score+`)`,
+		"SCORE_B", `(?P<bs>`+score+`)`,
+	)
+	return resultPattern{kind: kind, re: regexp.MustCompile(r.Replace(pattern))}
+}
+
+func cricketPatterns() patternSet {
+	// A score may be bracketed and followed by the overs, "(325-7, 50 overs)"
+	side := func(name, score string) string {
+		return name + `(?:\s+\(?` + score + `(?:,?\s*\(?[\d.]+\s+overs?\)?)?\)?)?`
+	}
+	return patternSet{sport: "cricket", patterns: []resultPattern{
+		expand(winnerFirst, side("TEAM_A", "SCORE_A")+`\s+(?:`+winVerbs+`)\s+`+side("TEAM_B", "SCORE_B")+`\s+by\s+`+cricketMargin, cricketScore),
+		expand(loserFirst, side("TEAM_A", "SCORE_A")+`\s+(?:`+loseVerbs+`)\s+`+side("TEAM_B", "SCORE_B")+`\s+by\s+`+cricketMargin, cricketScore),
+		expand(winnerOnly, side("TEAM_A", "SCORE_A")+`\s+(?:`+winAlone+`)\s+by\s+`+cricketMargin, cricketScore),
+		expand(drawn, `TEAM_A\s+`+versus+`\s+TEAM_B\s+(?:drew|played out a draw)|TEAM_A\s+drew\s+with\s+TEAM_B`, cricketScore),
+		expand(tied, `TEAM_A\s+`+versus+`\s+TEAM_B\s+(?:tied|played out a tie)|TEAM_A\s+tied\s+with\s+TEAM_B`, cricketScore),
+	}}
+}
+
+// scorelinePatterns are the patterns of sports reported as a scoreline, such
+// as "Arsenal 2-1 Chelsea" or "England beat Wales 24-10".
+func scorelinePatterns(sport, unit, score string) patternSet {
+	dash := `\s*[-–]\s*`
+	return patternSet{sport: sport, unit: unit, patterns: []resultPattern{
+		expand(winnerFirst, `TEAM_A\s+(?:`+winVerbs+`)\s+TEAM_B\s+SCORE_A`+dash+`SCORE_B`, score),
+		expand(loserFirst, `TEAM_A\s+lost\s+SCORE_B`+dash+`SCORE_A\s+to\s+TEAM_B|TEAM_A\s+(?:`+loseVerbs+`)\s+TEAM_B\s+SCORE_B`+dash+`SCORE_A`, score),
+		expand(drawn, `TEAM_A\s+drew\s+SCORE_A`+dash+`SCORE_B\s+(?:with|against)\s+TEAM_B`, score),
+		expand(scoreline, `TEAM_A\s+SCORE_A`+dash+`SCORE_B\s+TEAM_B`, score),
+	}}
+}
+
+var (
+	cricket  = cricketPatterns()
+	football = scorelinePatterns("football", "goals", `\d{1,2}`)
+	rugby    = scorelinePatterns("rugby", "points", `\d{1,3}`)
+)
+
+// patternSets returns the pattern sets tried for an article of the sport. An
+// unclassified article is tried as cricket, then as football.
+func patternSets(sport string) []patternSet {
+	switch sport {
+	case "":
+		return []patternSet{cricket, football}
+	case cricket.sport:
+		return []patternSet{cricket}
+	case football.sport:
+		return []patternSet{football}
+	case rugby.sport:
+		return []patternSet{rugby}
+	default:
+		return nil
+	}
+}
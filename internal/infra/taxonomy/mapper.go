@@ -0,0 +1,116 @@
+// Package taxonomy maps source-specific tags onto the canonical tag taxonomy.
+package taxonomy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+type compiledMapping struct {
+	mapping domain.TagMapping
+	regex   *regexp.Regexp
+}
+
+// Mapper resolves source tags to canonical tags. Exact mappings win over
+// alias mappings, which win over regex mappings; within each kind a
+// source-specific mapping wins over a wildcard one.
+type Mapper struct {
+	canonical map[string]domain.CanonicalTag
+	exact     map[string]map[string]string // source -> label or tag ID -> canonical ID
+	alias     map[string]map[string]string // source -> normalized label -> canonical ID
+	regex     map[string][]compiledMapping // source -> mappings in insertion order
+}
+
+// NewMapper compiles the mappings. Mappings pointing at unknown canonical tags are rejected.
+func NewMapper(tags []domain.CanonicalTag, mappings []domain.TagMapping) (*Mapper, error) {
+	m := &Mapper{
+		canonical: make(map[string]domain.CanonicalTag, len(tags)),
+		exact:     make(map[string]map[string]string),
+		alias:     make(map[string]map[string]string),
+		regex:     make(map[string][]compiledMapping),
+	}
+	for _, t := range tags {
+		m.canonical[t.ID] = t
+	}
+
+	for _, mp := range mappings {
+		if err := mp.Validate(); err != nil {
+			return nil, fmt.Errorf("tag mapping %s: %w", mp.ID, err)
+		}
+		if _, ok := m.canonical[mp.CanonicalID]; !ok {
+			return nil, fmt.Errorf("tag mapping %s: unknown canonical tag %q", mp.ID, mp.CanonicalID)
+		}
+		switch mp.MatchType {
+		case domain.TagMatchExact:
+			put(m.exact, mp.Source, mp.Pattern, mp.CanonicalID)
+		case domain.TagMatchAlias:
+			put(m.alias, mp.Source, normalizeLabel(mp.Pattern), mp.CanonicalID)
+		case domain.TagMatchRegex:
+			m.regex[mp.Source] = append(m.regex[mp.Source], compiledMapping{mapping: mp, regex: regexp.MustCompile(mp.Pattern)})
+		}
+	}
+	return m, nil
+}
+
+// Map returns the canonical tags for the source's tags (deduplicated, in tag
+// order) and the tags no mapping covered.
+func (m *Mapper) Map(source string, tags []domain.Tag) ([]domain.CanonicalTag, []domain.Tag) {
+	var canonical []domain.CanonicalTag
+	var unmapped []domain.Tag
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		id, ok := m.resolve(source, tag)
+		if !ok {
+			unmapped = append(unmapped, tag)
+			continue
+		}
+		if !seen[id] {
+			seen[id] = true
+			canonical = append(canonical, m.canonical[id])
+		}
+	}
+	return canonical, unmapped
+}
+
+func (m *Mapper) resolve(source string, tag domain.Tag) (string, bool) {
+	sources := []string{source, domain.AnySource}
+	tagID := strconv.Itoa(tag.ID)
+	for _, src := range sources {
+		if id, ok := m.exact[src][tag.Label]; ok {
+			return id, true
+		}
+		if id, ok := m.exact[src][tagID]; ok {
+			return id, true
+		}
+	}
+	label := normalizeLabel(tag.Label)
+	for _, src := range sources {
+		if id, ok := m.alias[src][label]; ok {
+			return id, true
+		}
+	}
+	for _, src := range sources {
+		for _, cm := range m.regex[src] {
+			if cm.regex.MatchString(tag.Label) {
+				return cm.mapping.CanonicalID, true
+			}
+		}
+	}
+	return "", false
+}
+
+// normalizeLabel lower-cases a label and collapses its whitespace.
+func normalizeLabel(label string) string {
+	return strings.Join(strings.Fields(strings.ToLower(label)), " ")
+}
+
+func put(index map[string]map[string]string, source, key, value string) {
+	if index[source] == nil {
+		index[source] = make(map[string]string)
+	}
+	index[source][key] = value
+}
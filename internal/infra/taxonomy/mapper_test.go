@@ -0,0 +1,46 @@
+package taxonomy
+
+import (
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapper_Map(t *testing.T) {
+	tags := []domain.CanonicalTag{
+		{ID: "england-men", Label: "England Men"},
+		{ID: "england-women", Label: "England Women"},
+		{ID: "county-cricket", Label: "County Cricket"},
+	}
+	mappings := []domain.TagMapping{
+		{ID: "1", Source: "pulselive", MatchType: domain.TagMatchExact, Pattern: "England Men", CanonicalID: "england-men"},
+		{ID: "2", Source: "pulselive", MatchType: domain.TagMatchExact, Pattern: "1234", CanonicalID: "england-women"},
+		{ID: "3", Source: domain.AnySource, MatchType: domain.TagMatchAlias, Pattern: "england  mens team", CanonicalID: "england-men"},
+		{ID: "4", Source: domain.AnySource, MatchType: domain.TagMatchRegex, Pattern: `(?i)county|championship`, CanonicalID: "county-cricket"},
+		{ID: "5", Source: "wire", MatchType: domain.TagMatchRegex, Pattern: `^ENG-W`, CanonicalID: "england-women"},
+	}
+	m, err := NewMapper(tags, mappings)
+	require.NoError(t, err)
+
+	canonical, unmapped := m.Map("pulselive", []domain.Tag{
+		{ID: 1, Label: "England Men"},
+		{ID: 1234, Label: "Women's team"},
+		{ID: 7, Label: "Rothesay County Championship"},
+		{ID: 8, Label: "Weather"},
+	})
+	assert.Equal(t, []domain.CanonicalTag{tags[0], tags[1], tags[2]}, canonical)
+	assert.Equal(t, []domain.Tag{{ID: 8, Label: "Weather"}}, unmapped)
+
+	// Another source with the same label lines up through the wildcard alias
+	canonical, _ = m.Map("wire", []domain.Tag{{Label: "England Mens Team"}, {Label: "ENG-W squad"}, {Label: "England Men"}})
+	assert.Equal(t, []domain.CanonicalTag{tags[0], tags[1]}, canonical)
+}
+
+func TestMapper_RejectsUnknownCanonicalTag(t *testing.T) {
+	_, err := NewMapper(nil, []domain.TagMapping{
+		{ID: "1", Source: "*", MatchType: domain.TagMatchExact, Pattern: "x", CanonicalID: "missing"},
+	})
+	assert.Error(t, err)
+}
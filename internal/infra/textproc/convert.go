@@ -0,0 +1,293 @@
+package textproc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var blockElements = map[string]bool{
+	"p": true, "div": true, "br": true, "hr": true, "li": true, "ul": true, "ol": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "pre": true, "figure": true, "figcaption": true,
+	"table": true, "tr": true, "caption": true,
+}
+
+// paragraphElements are separated from their surroundings by a blank line in plain text.
+var paragraphElements = map[string]bool{
+	"p": true, "ul": true, "ol": true, "blockquote": true, "pre": true, "table": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+var (
+	spaceRun   = regexp.MustCompile(`[ \t\r\f\v]+`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+	listIndent = regexp.MustCompile(`^ +(- |\d+\. )`)
+)
+
+// ToText extracts readable plain text from HTML. Block elements become line
+// breaks, paragraphs are separated by a blank line and entities are decoded.
+func ToText(in string) string {
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(in))
+	skipDepth := 0
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return tidy(b.String())
+		case html.TextToken:
+			if skipDepth == 0 {
+				b.WriteString(collapseSpace(strings.ReplaceAll(string(z.Text()), "\n", " ")))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if tag == "script" || tag == "style" {
+				if tt == html.StartTagToken {
+					skipDepth++
+				} else if tt == html.EndTagToken && skipDepth > 0 {
+					skipDepth--
+				}
+				continue
+			}
+			switch {
+			case paragraphElements[tag]:
+				endLine(&b, 2)
+			case blockElements[tag]:
+				endLine(&b, 1)
+			case tag == "td" || tag == "th":
+				b.WriteString(" ")
+			}
+		}
+	}
+}
+
+// endLine makes sure the text ends with at least n line breaks, ignoring
+// trailing spaces; it writes nothing at the very start.
+func endLine(b *strings.Builder, n int) {
+	current := strings.TrimRight(b.String(), " ")
+	if current == "" {
+		return
+	}
+	have := len(current) - len(strings.TrimRight(current, "\n"))
+	for ; have < n; have++ {
+		b.WriteString("\n")
+	}
+}
+
+// ToMarkdown converts (sanitized) HTML to CommonMark. Unsupported elements are
+// reduced to their text.
+func ToMarkdown(in string) string {
+	m := &markdownWriter{}
+	z := html.NewTokenizer(strings.NewReader(in))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			for len(m.stack) > 1 {
+				m.popBlockquote()
+			}
+			return tidy(m.current().String())
+		case html.TextToken:
+			m.text(string(z.Text()))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			m.start(z.Token())
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			m.end(string(name))
+		}
+	}
+}
+
+type listState struct {
+	ordered bool
+	index   int
+}
+
+type markdownWriter struct {
+	stack   []*strings.Builder // Output buffers; blockquotes push a new one
+	lists   []listState
+	links   []string
+	inPre   bool
+	skip    int
+	heading bool
+}
+
+func (m *markdownWriter) current() *strings.Builder {
+	if len(m.stack) == 0 {
+		m.stack = append(m.stack, &strings.Builder{})
+	}
+	return m.stack[len(m.stack)-1]
+}
+
+func (m *markdownWriter) write(s string) { m.current().WriteString(s) }
+
+func (m *markdownWriter) text(s string) {
+	if m.skip > 0 {
+		return
+	}
+	if m.inPre {
+		m.write(s)
+		return
+	}
+	s = collapseSpace(strings.ReplaceAll(s, "\n", " "))
+	if strings.TrimSpace(s) == "" {
+		if s != "" && !strings.HasSuffix(m.current().String(), " ") && !strings.HasSuffix(m.current().String(), "\n") {
+			m.write(" ")
+		}
+		return
+	}
+	m.write(escapeMarkdown(s))
+}
+
+func (m *markdownWriter) start(tok html.Token) {
+	if m.skip > 0 || tok.Data == "script" || tok.Data == "style" {
+		if tok.Type == html.StartTagToken {
+			m.skip++
+		}
+		return
+	}
+	switch tok.Data {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(tok.Data[1:])
+		m.write("\n\n" + strings.Repeat("#", level) + " ")
+		m.heading = true
+	case "p", "div", "figure", "table":
+		m.write("\n\n")
+	case "br":
+		if m.heading {
+			m.write(" ")
+		} else {
+			m.write("\\\n") // CommonMark hard line break
+		}
+	case "hr":
+		m.write("\n\n---\n\n")
+	case "strong", "b":
+		m.write("**")
+	case "em", "i":
+		m.write("_")
+	case "code":
+		if !m.inPre {
+			m.write("`")
+		}
+	case "pre":
+		m.write("\n\n```\n")
+		m.inPre = true
+	case "a":
+		m.links = append(m.links, attr(tok, "href"))
+		m.write("[")
+	case "img":
+		if src := attr(tok, "src"); src != "" {
+			m.write("![" + escapeMarkdown(attr(tok, "alt")) + "](" + src + ")")
+		}
+	case "ul", "ol":
+		m.lists = append(m.lists, listState{ordered: tok.Data == "ol"})
+		m.write("\n")
+	case "li":
+		indent := ""
+		if len(m.lists) > 1 {
+			indent = strings.Repeat("  ", len(m.lists)-1)
+		}
+		marker := "- "
+		if len(m.lists) > 0 && m.lists[len(m.lists)-1].ordered {
+			m.lists[len(m.lists)-1].index++
+			marker = strconv.Itoa(m.lists[len(m.lists)-1].index) + ". "
+		}
+		m.write("\n" + indent + marker)
+	case "blockquote":
+		m.stack = append(m.stack, &strings.Builder{})
+	case "tr":
+		m.write("\n| ")
+	case "figcaption", "caption":
+		m.write("\n\n")
+	}
+}
+
+func (m *markdownWriter) end(tag string) {
+	if m.skip > 0 {
+		if tag == "script" || tag == "style" {
+			m.skip--
+		}
+		return
+	}
+	switch tag {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		m.write("\n\n")
+		m.heading = false
+	case "p", "div", "figure", "table":
+		m.write("\n\n")
+	case "strong", "b":
+		m.write("**")
+	case "em", "i":
+		m.write("_")
+	case "code":
+		if !m.inPre {
+			m.write("`")
+		}
+	case "pre":
+		m.write("\n```\n\n")
+		m.inPre = false
+	case "a":
+		if n := len(m.links); n > 0 {
+			m.write("](" + m.links[n-1] + ")")
+			m.links = m.links[:n-1]
+		}
+	case "ul", "ol":
+		if n := len(m.lists); n > 0 {
+			m.lists = m.lists[:n-1]
+		}
+		m.write("\n\n")
+	case "blockquote":
+		if len(m.stack) > 1 {
+			m.popBlockquote()
+		}
+	case "td", "th":
+		m.write(" | ")
+	}
+}
+
+// popBlockquote prefixes the innermost buffered block with "> " and appends it to its parent.
+func (m *markdownWriter) popBlockquote() {
+	inner := tidy(m.current().String())
+	m.stack = m.stack[:len(m.stack)-1]
+	lines := strings.Split(inner, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	m.write("\n\n" + strings.Join(lines, "\n") + "\n\n")
+}
+
+func attr(tok html.Token, key string) string {
+	for _, a := range tok.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+func collapseSpace(s string) string {
+	return spaceRun.ReplaceAllString(s, " ")
+}
+
+// tidy trims each line (keeping list indentation), collapses runs of blank
+// lines and trims the result.
+func tidy(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if !listIndent.MatchString(line) {
+			line = strings.TrimLeft(line, " \t")
+		}
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
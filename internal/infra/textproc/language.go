@@ -0,0 +1,115 @@
+package textproc
+
+import (
+	"sort"
+	"unicode"
+)
+
+// minLanguageHits is the number of function words a Latin-script text needs
+// before its language is guessed.
+const minLanguageHits = 3
+
+// languageProfiles are the most frequent function words of the Latin-script
+// languages told apart by their vocabulary, keyed by ISO 639-1 code.
+var languageProfiles = map[string]map[string]bool{
+	"en": toSet(`the and of to in is was for on that with he it as at his by from they have had not are but this be were which their has after first`),
+	"fr": toSet(`le la les de des du et est un une dans pour sur au aux qui que pas par avec il elle ce son sa ses été ont mais plus`),
+	"es": toSet(`el la los las de del y en que por con para una un es se su sus al lo como más pero fue ha han sobre ya`),
+	"de": toSet(`der die das und ist nicht ein eine zu den mit von im dem des auf für sich auch es als noch nach wird hat war sind`),
+	"it": toSet(`il lo la gli le di del della che è un una per con non sono nel alla dei ha anche come più ma dopo questo`),
+	"pt": toSet(`o os as de do da dos das que em um uma para com não no na por mais se foi como ao são seu sua mas`),
+	"nl": toSet(`de het een en van is dat op te in voor niet met zijn aan er ook als bij door maar nog werd heeft naar`),
+}
+
+// scriptLanguages are the languages identified by their script alone.
+var scriptLanguages = []struct {
+	table *unicode.RangeTable
+	lang  string
+}{
+	{unicode.Devanagari, "hi"},
+	{unicode.Bengali, "bn"},
+	{unicode.Tamil, "ta"},
+	{unicode.Sinhala, "si"},
+	{unicode.Arabic, "ar"},
+	{unicode.Hangul, "ko"},
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Han, "zh"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Greek, "el"},
+	{unicode.Thai, "th"},
+}
+
+// DetectLanguage returns the ISO 639-1 code of the language text is written
+// in, or "" when it cannot tell. Non-Latin scripts decide on their own; Latin
+// text is matched against the function words of each known language.
+func DetectLanguage(text string) string {
+	if lang := scriptLanguage(text); lang != "" {
+		return lang
+	}
+
+	hits := make(map[string]int, len(languageProfiles))
+	for _, w := range LowerWords(text) {
+		for lang, profile := range languageProfiles {
+			if profile[w] {
+				hits[lang]++
+			}
+		}
+	}
+	langs := make([]string, 0, len(hits))
+	for lang := range hits {
+		langs = append(langs, lang)
+	}
+	sort.Slice(langs, func(a, b int) bool {
+		if hits[langs[a]] != hits[langs[b]] {
+			return hits[langs[a]] > hits[langs[b]]
+		}
+		return langs[a] < langs[b]
+	})
+	if len(langs) == 0 || hits[langs[0]] < minLanguageHits {
+		return ""
+	}
+	// Too close to call, e.g. a short text of shared words such as "de"
+	if len(langs) > 1 && hits[langs[1]]*5 > hits[langs[0]]*4 {
+		return ""
+	}
+	return langs[0]
+}
+
+// scriptLanguage returns the language of the non-Latin script most letters of
+// text are written in, or "" when most are Latin.
+func scriptLanguage(text string) string {
+	counts := make(map[string]int)
+	letters, kana := 0, 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		for _, s := range scriptLanguages {
+			if unicode.Is(s.table, r) {
+				counts[s.lang]++
+				if s.lang == "ja" {
+					kana++
+				}
+				break
+			}
+		}
+	}
+	// Japanese mixes kanji with kana
+	if kana > 0 && kana*10 >= counts["zh"] {
+		counts["ja"] += counts["zh"]
+		delete(counts, "zh")
+	}
+
+	best := ""
+	for _, s := range scriptLanguages {
+		if counts[s.lang] > counts[best] {
+			best = s.lang
+		}
+	}
+	if best == "" || counts[best]*2 <= letters {
+		return ""
+	}
+	return best
+}
@@ -0,0 +1,15 @@
+package textproc
+
+import (
+	"html"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize puts text in a canonical form for comparison: HTML entities
+// decoded, Unicode NFC and whitespace runs collapsed to a single space.
+func Normalize(text string) string {
+	text = norm.NFC.String(html.UnescapeString(text))
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,193 @@
+// Package textproc provides the text processing primitives used by enrichers:
+// HTML sanitization and conversion, tokenization and text statistics.
+package textproc
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Policy is an allowlist of elements and, per element, the attributes kept on it.
+// Anything not listed is removed; elements in DropContent are removed together
+// with everything inside them.
+type Policy struct {
+	Elements    map[string][]string
+	DropContent map[string]bool
+	// URLSchemes allowed in href/src attributes. Relative URLs are always kept.
+	URLSchemes map[string]bool
+}
+
+// DefaultPolicy keeps editorial markup (text formatting, lists, links, images,
+// tables) and strips scripts, styles, iframes and other embeds.
+func DefaultPolicy() *Policy {
+	basic := []string{}
+	return &Policy{
+		Elements: map[string][]string{
+			"p": basic, "br": basic, "hr": basic, "div": basic, "span": basic,
+			"h1": basic, "h2": basic, "h3": basic, "h4": basic, "h5": basic, "h6": basic,
+			"strong": basic, "b": basic, "em": basic, "i": basic, "u": basic, "s": basic,
+			"sub": basic, "sup": basic, "small": basic, "mark": basic,
+			"blockquote": {"cite"}, "q": {"cite"}, "cite": basic,
+			"ul": basic, "ol": basic, "li": basic,
+			"pre": basic, "code": basic,
+			"a":          {"href", "title"},
+			"img":        {"src", "alt", "title", "width", "height"},
+			"figure":     basic,
+			"figcaption": basic,
+			"table":      basic, "caption": basic, "thead": basic, "tbody": basic, "tfoot": basic,
+			"tr": basic, "th": {"colspan", "rowspan"}, "td": {"colspan", "rowspan"},
+		},
+		DropContent: map[string]bool{
+			"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+			"noscript": true, "template": true, "svg": true, "math": true, "form": true,
+			"button": true, "select": true, "textarea": true, "head": true, "title": true,
+			"frameset": true, "frame": true, "applet": true, "video": true, "audio": true,
+		},
+		URLSchemes: map[string]bool{"http": true, "https": true, "mailto": true},
+	}
+}
+
+var voidElements = map[string]bool{
+	"br": true, "hr": true, "img": true, "input": true, "meta": true, "link": true,
+	"area": true, "base": true, "col": true, "embed": true, "source": true, "track": true, "wbr": true,
+}
+
+// Sanitize returns in with every element, attribute and URL not allowed by the
+// policy removed. The output is well-formed: every emitted element is closed.
+func (p *Policy) Sanitize(in string) string {
+	var b strings.Builder
+	var open []string // Stack of emitted, unclosed elements
+	skipTag, skipDepth := "", 0
+
+	z := html.NewTokenizer(strings.NewReader(in))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			for i := len(open) - 1; i >= 0; i-- {
+				b.WriteString("</" + open[i] + ">")
+			}
+			return b.String()
+
+		case html.TextToken:
+			if skipDepth == 0 {
+				b.WriteString(html.EscapeString(string(z.Text())))
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			name := tok.Data
+			if skipDepth > 0 {
+				if name == skipTag && tt == html.StartTagToken {
+					skipDepth++
+				}
+				continue
+			}
+			if p.DropContent[name] {
+				if tt == html.StartTagToken && !voidElements[name] {
+					skipTag, skipDepth = name, 1
+				}
+				continue
+			}
+			allowed, ok := p.Elements[name]
+			if !ok {
+				continue // Unwrap: drop the tag, keep its text
+			}
+			attrs := p.filterAttributes(name, tok.Attr, allowed)
+			if name == "img" && (attrs == nil || isTrackingPixel(attrs)) {
+				continue
+			}
+			b.WriteString("<" + name)
+			for _, a := range attrs {
+				b.WriteString(" " + a.Key + `="` + html.EscapeString(a.Val) + `"`)
+			}
+			b.WriteString(">")
+			if !voidElements[name] {
+				open = append(open, name)
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if skipDepth > 0 {
+				if tag == skipTag {
+					skipDepth--
+				}
+				continue
+			}
+			// Close up to the matching element; stray end tags are ignored
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == tag {
+					for j := len(open) - 1; j >= i; j-- {
+						b.WriteString("</" + open[j] + ">")
+					}
+					open = open[:i]
+					break
+				}
+			}
+		}
+		// Comments and doctypes are dropped
+	}
+}
+
+// filterAttributes keeps allowed attributes with safe URLs. It returns nil for an
+// img whose src was rejected, so the caller can drop the element.
+func (p *Policy) filterAttributes(element string, attrs []html.Attribute, allowed []string) []html.Attribute {
+	var kept []html.Attribute
+	hasSrc := false
+	for _, a := range attrs {
+		key := strings.ToLower(a.Key)
+		if !contains(allowed, key) {
+			continue
+		}
+		if key == "href" || key == "src" || key == "cite" {
+			if !p.safeURL(a.Val) {
+				continue
+			}
+			if key == "src" {
+				hasSrc = true
+			}
+		}
+		kept = append(kept, html.Attribute{Key: key, Val: a.Val})
+	}
+	if element == "img" && !hasSrc {
+		return nil
+	}
+	if kept == nil {
+		kept = []html.Attribute{}
+	}
+	return kept
+}
+
+func (p *Policy) safeURL(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return true
+	}
+	return p.URLSchemes[strings.ToLower(u.Scheme)]
+}
+
+// isTrackingPixel reports whether an image is a 1x1 (or smaller) beacon.
+func isTrackingPixel(attrs []html.Attribute) bool {
+	small := 0
+	for _, a := range attrs {
+		if (a.Key == "width" || a.Key == "height") && (a.Val == "0" || a.Val == "1") {
+			small++
+		}
+	}
+	return small == 2
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
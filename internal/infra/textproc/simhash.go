@@ -0,0 +1,49 @@
+package textproc
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+// SimHash returns the 64-bit SimHash of the word shingles of size n. Similar
+// texts get fingerprints with a small Hamming distance.
+func SimHash(words []string, n int) uint64 {
+	if len(words) == 0 {
+		return 0
+	}
+	if n < 1 {
+		n = 1
+	}
+	if n > len(words) {
+		n = len(words) // Short texts become a single shingle
+	}
+
+	var weights [64]int
+	h := fnv.New64a()
+	for i := 0; i+n <= len(words); i++ {
+		h.Reset()
+		_, _ = h.Write([]byte(strings.Join(words[i:i+n], " ")))
+		sum := h.Sum64()
+		for b := 0; b < 64; b++ {
+			if sum&(1<<b) != 0 {
+				weights[b]++
+			} else {
+				weights[b]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for b, w := range weights {
+		if w > 0 {
+			fingerprint |= 1 << b
+		}
+	}
+	return fingerprint
+}
+
+// HammingDistance returns the number of bits that differ between a and b.
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,23 @@
+package textproc
+
+// stopwords are common English function words that carry no topical meaning.
+var stopwords = toSet(`a about above after again against all also am an and any are as at be because been
+before being below between both but by can could did do does doing down during each few for from further
+had has have having he her here hers herself him himself his how i if in into is it its itself just me
+more most my myself no nor not now of off on once only or other our ours ourselves out over own said same
+says she should so some such than that the their theirs them themselves then there these they this those
+through to too under until up very was we were what when where which while who whom why will with would
+you your yours yourself yourselves`)
+
+// IsStopword reports whether the lower-cased word is an English stopword.
+func IsStopword(word string) bool {
+	return stopwords[word]
+}
+
+func toSet(list string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range Words(list) {
+		set[w] = true
+	}
+	return set
+}
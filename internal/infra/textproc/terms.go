@@ -0,0 +1,35 @@
+package textproc
+
+import "math"
+
+// TermFrequencies counts the content words of text: lower-cased, without
+// stopwords and single characters.
+func TermFrequencies(text string) map[string]float64 {
+	terms := make(map[string]float64)
+	for _, w := range LowerWords(text) {
+		if len([]rune(w)) < 2 || IsStopword(w) {
+			continue
+		}
+		terms[w]++
+	}
+	return terms
+}
+
+// CosineSimilarity returns the cosine of the angle between two term vectors, in [0, 1].
+func CosineSimilarity(a, b map[string]float64) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var dot, normA, normB float64
+	for term, w := range a {
+		dot += w * b[term]
+		normA += w * w
+	}
+	for _, w := range b {
+		normB += w * w
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
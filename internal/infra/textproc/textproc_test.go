@@ -0,0 +1,126 @@
+package textproc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Trimmed from a PulseLive article body, including its embeds.
+const pulseLiveBody = `<p>England sealed a <strong>thrilling</strong> win at <a href="https://www.ecb.co.uk/venues/lords" onclick="track()">Lord's</a>.</p>
+<script>window.analytics.push({event: "view"})</script>
+<div class="embed"><iframe src="https://www.youtube.com/embed/abc"></iframe></div>
+<p>Key moments:</p>
+<ul><li>Root 100*</li><li>Wood 5-42</li></ul>
+<blockquote class="twitter-tweet"><p>What a day at HQ</p></blockquote>
+<img src="https://pixel.example.com/t.gif" width="1" height="1">
+<img src="https://resources.ecb.co.uk/photo.jpg" alt="Root celebrates" style="border:0">
+<a href="javascript:alert(1)">bad link</a>
+<!-- tracking comment -->
+<p>Fish &amp; chips</p>`
+
+func TestPolicy_Sanitize(t *testing.T) {
+	out := DefaultPolicy().Sanitize(pulseLiveBody)
+
+	assert.NotContains(t, out, "<script")
+	assert.NotContains(t, out, "analytics")
+	assert.NotContains(t, out, "iframe")
+	assert.NotContains(t, out, "onclick")
+	assert.NotContains(t, out, "class=")
+	assert.NotContains(t, out, "style=")
+	assert.NotContains(t, out, "pixel.example.com")
+	assert.NotContains(t, out, "javascript:")
+	assert.NotContains(t, out, "tracking comment")
+	assert.Contains(t, out, `<a href="https://www.ecb.co.uk/venues/lords">Lord&#39;s</a>`)
+	assert.Contains(t, out, `<img src="https://resources.ecb.co.uk/photo.jpg" alt="Root celebrates">`)
+	assert.Contains(t, out, "<a>bad link</a>")
+	assert.Contains(t, out, "Fish &amp; chips")
+}
+
+func TestPolicy_Sanitize_ClosesUnbalancedTags(t *testing.T) {
+	out := DefaultPolicy().Sanitize(`<p><strong>open</p></em>text`)
+	assert.Equal(t, `<p><strong>open</strong></p>text`, out)
+}
+
+func TestToText(t *testing.T) {
+	text := ToText(DefaultPolicy().Sanitize(pulseLiveBody))
+	assert.Equal(t, "England sealed a thrilling win at Lord's.\n\nKey moments:\n\nRoot 100*\nWood 5-42\n\nWhat a day at HQ\n\nbad link\n\nFish & chips", text)
+}
+
+func TestToMarkdown(t *testing.T) {
+	md := ToMarkdown(`<h2>Report</h2><p>England <strong>won</strong> at <a href="https://ecb.co.uk">Lord's</a><br>by 45 runs</p>` +
+		`<ol><li>Root</li><li>Wood</li></ol><blockquote><p>Quote</p></blockquote><p>5*</p>`)
+	assert.Equal(t, "## Report\n\nEngland **won** at [Lord's](https://ecb.co.uk)\\\nby 45 runs\n\n1. Root\n2. Wood\n\n> Quote\n\n5\\*", md)
+}
+
+func TestWords(t *testing.T) {
+	assert.Equal(t, []string{"Root's", "run-out", "cost", "England", "45", "runs"},
+		Words("Root's run-out cost England -- 45 runs!"))
+	assert.Equal(t, 0, WordCount("  "))
+	assert.Equal(t, 0, ReadingTimeMinutes(0, 230))
+	assert.Equal(t, 1, ReadingTimeMinutes(10, 230))
+	assert.Equal(t, 2, ReadingTimeMinutes(231, 230))
+}
+
+func TestSimHash(t *testing.T) {
+	wire := LowerWords("England won the first Test against Australia at Lord's by 45 runs on Sunday, " +
+		"with Joe Root scoring an unbeaten century and Mark Wood taking five wickets in the final session.")
+	edited := LowerWords("England won the first Test against Australia at Lord's by 45 runs on Sunday, " +
+		"with Joe Root scoring an unbeaten century and Mark Wood taking five wickets in the last session.")
+	other := LowerWords("Surrey beat Somerset by an innings at the Oval to go top of the County Championship " +
+		"table after Jamie Overton and Dan Worrall shared eight wickets on a green pitch.")
+
+	assert.Equal(t, SimHash(wire, 3), SimHash(wire, 3))
+	assert.Less(t, HammingDistance(SimHash(wire, 3), SimHash(edited, 3)), HammingDistance(SimHash(wire, 3), SimHash(other, 3)))
+	assert.Greater(t, HammingDistance(SimHash(wire, 3), SimHash(other, 3)), 10)
+	assert.Equal(t, uint64(0), SimHash(nil, 3))
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "Lord’s caf\u00e9 & more", Normalize("  Lord&rsquo;s  cafe\u0301\n&amp;\tmore "))
+	assert.Equal(t, Normalize("<p>England  win</p>"), Normalize("<p>England win</p>\n"))
+}
+
+const matchReport = `England beat Australia by 45 runs at Lord's to take a 1-0 lead in the Ashes series.
+Joe Root scored an unbeaten century as England posted 320 on the opening day at Lord's.
+Mr. Stokes said the team had been preparing for weeks. "It was a special day," he said.
+Mark Wood took five wickets as Australia were bowled out for 275 in reply at Lord's.
+The second Test of the Ashes series starts at Edgbaston on Thursday, with England unchanged.`
+
+func TestSentences(t *testing.T) {
+	assert.Equal(t, []string{
+		"Mr. Stokes thanked J. Root.",
+		`"It was a special day!" he said.`,
+		"Rain stopped play",
+	}, Sentences("Mr. Stokes thanked J. Root. \"It was a special day!\" he said.\nRain stopped play"))
+}
+
+func TestSummarize(t *testing.T) {
+	summary := Summarize(matchReport, 2)
+
+	assert.Len(t, Sentences(summary), 2)
+	assert.Contains(t, summary, "England beat Australia by 45 runs at Lord's", "the most central sentence is picked")
+	assert.Equal(t, Summarize(matchReport, 2), summary)
+	assert.Equal(t, "One sentence.", Summarize("One sentence.", 3))
+	assert.Empty(t, Summarize("", 2))
+}
+
+func TestKeywords(t *testing.T) {
+	keywords := Keywords(matchReport, 5)
+
+	assert.Len(t, keywords, 5)
+	assert.Contains(t, keywords, "england")
+	for _, k := range keywords {
+		assert.NotContains(t, []string{"the", "at", "by", "45"}, k)
+	}
+	assert.Nil(t, Keywords("the and of", 5))
+}
+
+func TestDetectLanguage(t *testing.T) {
+	assert.Equal(t, "en", DetectLanguage(matchReport))
+	assert.Equal(t, "fr", DetectLanguage("Joe Root a marqué un siècle pour l'Angleterre et les spectateurs étaient ravis dans le stade."))
+	assert.Equal(t, "es", DetectLanguage("El capitán de Inglaterra anotó su centenario y los aficionados celebraron en el estadio con sus familias."))
+	assert.Equal(t, "de", DetectLanguage("Der Kapitän hat für England ein Jahrhundert erzielt und die Zuschauer sind auf den Rängen nicht mehr zu halten."))
+	assert.Equal(t, "hi", DetectLanguage("जो रूट ने इंग्लैंड के लिए शतक बनाया"))
+	assert.Equal(t, "", DetectLanguage("Root 104*"), "too little text")
+}
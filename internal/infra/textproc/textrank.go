@@ -0,0 +1,308 @@
+package textproc
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	textRankDamping    = 0.85
+	textRankIterations = 50
+	textRankTolerance  = 1e-6
+	keywordWindow      = 3 // Candidate words co-occurring within this many are linked
+	maxKeyphraseWords  = 3
+)
+
+// abbreviations end with a period that does not end a sentence.
+var abbreviations = toSet(`mr mrs ms dr st vs v no jr sr prof rev gen capt sgt lt col etc approx`)
+
+// Sentences splits plain text into sentences at ., ! and ? followed by a space
+// and at line breaks, skipping abbreviations and initials such as "J. Root".
+func Sentences(text string) []string {
+	var sentences []string
+	for _, line := range strings.Split(text, "\n") {
+		runes := []rune(strings.TrimSpace(line))
+		start := 0
+		for i, r := range runes {
+			if r != '.' && r != '!' && r != '?' {
+				continue
+			}
+			// Closing quotes and brackets belong to the sentence
+			end := i + 1
+			for end < len(runes) && strings.ContainsRune(`"'’”)`, runes[end]) {
+				end++
+			}
+			if end < len(runes) && !unicode.IsSpace(runes[end]) {
+				continue
+			}
+			// A sentence does not start lower-case, as in: "Well played!" he said.
+			if next := nextNonSpace(runes, end); next >= 0 && unicode.IsLower(runes[next]) {
+				continue
+			}
+			if r == '.' && endsWithAbbreviation(runes[start:i]) {
+				continue
+			}
+			if s := strings.TrimSpace(string(runes[start:end])); s != "" {
+				sentences = append(sentences, s)
+			}
+			start = end
+		}
+		if s := strings.TrimSpace(string(runes[start:])); s != "" {
+			sentences = append(sentences, s)
+		}
+	}
+	return sentences
+}
+
+func nextNonSpace(runes []rune, from int) int {
+	for i := from; i < len(runes); i++ {
+		if !unicode.IsSpace(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+func endsWithAbbreviation(before []rune) bool {
+	i := len(before)
+	for i > 0 && unicode.IsLetter(before[i-1]) {
+		i--
+	}
+	word := string(before[i:])
+	if word == "" {
+		return false
+	}
+	// A single capital letter is an initial
+	if len([]rune(word)) == 1 && unicode.IsUpper([]rune(word)[0]) {
+		return true
+	}
+	return abbreviations[strings.ToLower(word)]
+}
+
+// Summarize returns the n most central sentences of text in their original
+// order, ranked by TextRank over sentence word overlap.
+func Summarize(text string, n int) string {
+	sentences := Sentences(text)
+	if n <= 0 || len(sentences) == 0 {
+		return ""
+	}
+	if len(sentences) <= n {
+		return strings.Join(sentences, " ")
+	}
+
+	terms := make([]map[string]float64, len(sentences))
+	for i, s := range sentences {
+		terms[i] = TermFrequencies(s)
+	}
+	graph := make([]map[int]float64, len(sentences))
+	for i := range sentences {
+		graph[i] = make(map[int]float64)
+		for j := range sentences {
+			if w := sentenceSimilarity(terms[i], terms[j]); i != j && w > 0 {
+				graph[i][j] = w
+			}
+		}
+	}
+	scores := textRank(graph)
+
+	order := make([]int, len(sentences))
+	for i := range order {
+		order[i] = i
+	}
+	// Ties go to the earlier sentence
+	sort.SliceStable(order, func(a, b int) bool { return scores[order[a]] > scores[order[b]] })
+	top := order[:n]
+	sort.Ints(top)
+
+	picked := make([]string, 0, n)
+	for _, i := range top {
+		picked = append(picked, sentences[i])
+	}
+	return strings.Join(picked, " ")
+}
+
+// sentenceSimilarity is the TextRank overlap of two sentences, normalized by
+// their lengths so long sentences are not favoured.
+func sentenceSimilarity(a, b map[string]float64) float64 {
+	shared := 0.0
+	for w := range a {
+		if b[w] > 0 {
+			shared++
+		}
+	}
+	if shared == 0 {
+		return 0
+	}
+	norm := math.Log(float64(len(a))+1) + math.Log(float64(len(b))+1)
+	return shared / norm
+}
+
+// Keywords returns up to n key words and phrases of text, most important
+// first. Content words are ranked by TextRank over their co-occurrence, and
+// adjacent top-ranked words are joined into phrases such as "joe root".
+func Keywords(text string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	words := LowerWords(text)
+
+	index := make(map[string]int)
+	var vocab []string
+	var sequence []int // Candidate word indexes, -1 for words that break phrases
+	for _, w := range words {
+		if !isKeywordCandidate(w) {
+			sequence = append(sequence, -1)
+			continue
+		}
+		id, ok := index[w]
+		if !ok {
+			id = len(vocab)
+			index[w] = id
+			vocab = append(vocab, w)
+		}
+		sequence = append(sequence, id)
+	}
+	if len(vocab) == 0 {
+		return nil
+	}
+
+	graph := make([]map[int]float64, len(vocab))
+	for i := range graph {
+		graph[i] = make(map[int]float64)
+	}
+	var candidates []int
+	for _, id := range sequence {
+		if id >= 0 {
+			candidates = append(candidates, id)
+		}
+	}
+	for i, a := range candidates {
+		for j := i + 1; j < len(candidates) && j < i+keywordWindow; j++ {
+			if b := candidates[j]; a != b {
+				graph[a][b]++
+				graph[b][a]++
+			}
+		}
+	}
+	scores := textRank(graph)
+
+	// The top third of the words, at least n, can form keywords
+	ranked := make([]int, len(vocab))
+	for i := range ranked {
+		ranked[i] = i
+	}
+	sort.Slice(ranked, func(a, b int) bool {
+		if scores[ranked[a]] != scores[ranked[b]] {
+			return scores[ranked[a]] > scores[ranked[b]]
+		}
+		return vocab[ranked[a]] < vocab[ranked[b]]
+	})
+	keep := len(vocab) / 3
+	if keep < n {
+		keep = n
+	}
+	if keep > len(vocab) {
+		keep = len(vocab)
+	}
+	selected := make(map[int]bool, keep)
+	for _, id := range ranked[:keep] {
+		selected[id] = true
+	}
+
+	phrases := make(map[string]float64)
+	var run []int
+	flush := func() {
+		for len(run) > 0 {
+			size := len(run)
+			if size > maxKeyphraseWords {
+				size = maxKeyphraseWords
+			}
+			parts := make([]string, size)
+			score := 0.0
+			for k, id := range run[:size] {
+				parts[k] = vocab[id]
+				score += scores[id]
+			}
+			phrases[strings.Join(parts, " ")] = score
+			run = run[size:]
+		}
+	}
+	for _, id := range sequence {
+		if id >= 0 && selected[id] {
+			run = append(run, id)
+			continue
+		}
+		flush()
+	}
+	flush()
+
+	keywords := make([]string, 0, len(phrases))
+	for p := range phrases {
+		keywords = append(keywords, p)
+	}
+	sort.Slice(keywords, func(a, b int) bool {
+		if phrases[keywords[a]] != phrases[keywords[b]] {
+			return phrases[keywords[a]] > phrases[keywords[b]]
+		}
+		return keywords[a] < keywords[b]
+	})
+	if len(keywords) > n {
+		keywords = keywords[:n]
+	}
+	return keywords
+}
+
+func isKeywordCandidate(w string) bool {
+	if len([]rune(w)) < 3 || IsStopword(w) {
+		return false
+	}
+	for _, r := range w {
+		if !unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// textRank runs weighted PageRank over an undirected graph given as each
+// vertex's edge weights by neighbour. Neighbours are visited in order so the
+// scores, and the summaries hashed from them, are deterministic.
+func textRank(graph []map[int]float64) []float64 {
+	n := len(graph)
+	neighbours := make([][]int, n)
+	outSum := make([]float64, n)
+	for i, edges := range graph {
+		for j := range edges {
+			neighbours[i] = append(neighbours[i], j)
+		}
+		sort.Ints(neighbours[i])
+		for _, j := range neighbours[i] {
+			outSum[i] += edges[j]
+		}
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1
+	}
+	next := make([]float64, n)
+	for iter := 0; iter < textRankIterations; iter++ {
+		delta := 0.0
+		for i, edges := range graph {
+			sum := 0.0
+			// Undirected: the weight from j to i is the weight from i to j
+			for _, j := range neighbours[i] {
+				sum += edges[j] / outSum[j] * scores[j]
+			}
+			next[i] = (1 - textRankDamping) + textRankDamping*sum
+			delta += math.Abs(next[i] - scores[i])
+		}
+		scores, next = next, scores
+		if delta < textRankTolerance {
+			break
+		}
+	}
+	return scores
+}
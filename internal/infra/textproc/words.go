@@ -0,0 +1,55 @@
+package textproc
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Words splits text into words: runs of letters and digits, allowing inner
+// apostrophes and hyphens ("don't", "run-out").
+func Words(text string) []string {
+	var words []string
+	start := -1
+	runes := []rune(text)
+	for i, r := range runes {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if !inWord && start >= 0 && (r == '\'' || r == '’' || r == '-') && i+1 < len(runes) &&
+			(unicode.IsLetter(runes[i+1]) || unicode.IsDigit(runes[i+1])) {
+			inWord = true
+		}
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			words = append(words, string(runes[start:i]))
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// WordCount returns the number of words in text.
+func WordCount(text string) int {
+	return len(Words(text))
+}
+
+// ReadingTimeMinutes estimates reading time at the given words-per-minute rate,
+// rounded up. Any non-empty text takes at least one minute.
+func ReadingTimeMinutes(words, wordsPerMinute int) int {
+	if words <= 0 || wordsPerMinute <= 0 {
+		return 0
+	}
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
+
+// LowerWords returns the words of text lower-cased.
+func LowerWords(text string) []string {
+	words := Words(text)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return words
+}
@@ -0,0 +1,26 @@
+package transformer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// bylineSeparator splits "A, B and C" into names.
+var bylineSeparator = regexp.MustCompile(`\s*(?:,|&|\band\b)\s*`)
+
+// parseByline returns the authors named in a byline such as "By Jane Doe and John Smith".
+func parseByline(byline string) []domain.Author {
+	byline = strings.TrimSpace(byline)
+	if len(byline) > 3 && strings.EqualFold(byline[:3], "by ") {
+		byline = byline[3:]
+	}
+	var authors []domain.Author
+	for _, name := range bylineSeparator.Split(byline, -1) {
+		if name = strings.TrimSpace(name); name != "" {
+			authors = append(authors, domain.Author{Name: name})
+		}
+	}
+	return authors
+}
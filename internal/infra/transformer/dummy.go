@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"time"
 
 	"github.com/SportsNewsCrawler/internal/domain"
@@ -17,10 +18,15 @@ func NewDummyTransformer() *DummyTransformer {
 
 // Dummy payload structure
 type DummyArticle struct {
-	ID        string `json:"id"`
-	Headline  string `json:"headline"`
-	Content   string `json:"content"`
-	Timestamp string `json:"timestamp"`
+	ID        string         `json:"id"`
+	Headline  string         `json:"headline"`
+	Content   string         `json:"content"`
+	Timestamp string         `json:"timestamp"`
+	Media     []domain.Media `json:"media"`
+	Author    string         `json:"author"`
+	License   string         `json:"license"`
+	Embargo   string         `json:"embargo_until"` // RFC 3339
+	Language  string         `json:"language"`
 }
 
 type DummyResponse struct {
@@ -35,18 +41,45 @@ func (t *DummyTransformer) Transform(reader io.Reader) ([]domain.Article, *domai
 
 	articles := make([]domain.Article, 0, len(resp.Items))
 	for _, item := range resp.Items {
-		ts, _ := time.Parse(time.RFC3339, item.Timestamp)
-		articles = append(articles, domain.Article{
+		// A bad timestamp leaves PublishedAt zero so validation can quarantine the article
+		ts, err := time.Parse(time.RFC3339, item.Timestamp)
+		if err != nil {
+			slog.Warn("Invalid dummy timestamp", "id", item.ID, "timestamp", item.Timestamp, "error", err)
+		}
+		var embargo *time.Time
+		if item.Embargo != "" {
+			if until, err := time.Parse(time.RFC3339, item.Embargo); err == nil {
+				embargo = &until
+			} else {
+				slog.Warn("Invalid dummy embargo", "id", item.ID, "embargo_until", item.Embargo, "error", err)
+			}
+		}
+		var imageURL string
+		for i := range item.Media {
+			if item.Media[i].MIMEType == "" {
+				item.Media[i].MIMEType = mimeTypeOf(item.Media[i].URL)
+			}
+		}
+		if len(item.Media) > 0 {
+			imageURL = leadImageURL(item.Media[0])
+		}
+		article := domain.Article{
 			ID:          "dummy_" + item.ID,
 			ExternalID:  item.ID,
 			Source:      "dummy",
 			Title:       item.Headline,
-			Summary:     item.Content[:10] + "...",
 			Body:        item.Content,
 			PublishedAt: ts,
 			UpdatedAt:   ts,
 			URL:         "http://dummy/" + item.ID,
-		})
+			ImageURL:    imageURL,
+			Media:       item.Media,
+			Authors:     parseByline(item.Author),
+			Rights:      domain.Rights{License: item.License},
+			Language:    item.Language,
+		}
+		article.EmbargoUntil = embargo
+		articles = append(articles, article)
 	}
 
 	// Mock PageInfo for pagination testing
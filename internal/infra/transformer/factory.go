@@ -18,3 +18,13 @@ func GetTransformer(name string) (domain.Transformer, error) {
 		return nil, fmt.Errorf("transformer not found: %s", name)
 	}
 }
+
+// GetMatchTransformer returns the fixtures and results transformer by name.
+func GetMatchTransformer(name string) (domain.MatchTransformer, error) {
+	switch name {
+	case PulseLiveMatchesName:
+		return NewPulseLiveMatchTransformer(), nil
+	default:
+		return nil, fmt.Errorf("match transformer not found: %s", name)
+	}
+}
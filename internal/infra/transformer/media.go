@@ -0,0 +1,48 @@
+package transformer
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+// mediaMIMETypes are the MIME types of the media file extensions feeds use.
+var mediaMIMETypes = map[string]string{
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".gif":  "image/gif",
+	".webp": "image/webp",
+	".avif": "image/avif",
+	".svg":  "image/svg+xml",
+	".mp4":  "video/mp4",
+	".webm": "video/webm",
+	".m3u8": "application/vnd.apple.mpegurl",
+	".mpd":  "application/dash+xml",
+}
+
+// mimeTypeOf guesses a media file's MIME type from its URL extension; it is
+// empty when unknown.
+func mimeTypeOf(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return mediaMIMETypes[strings.ToLower(path.Ext(u.Path))]
+}
+
+// leadImageURL returns the image representing the media: the image itself, a
+// video's poster or a gallery's first photo.
+func leadImageURL(m domain.Media) string {
+	switch {
+	case m.Type == domain.MediaImage:
+		return m.URL
+	case m.Type == domain.MediaGallery && len(m.Items) > 0:
+		return leadImageURL(m.Items[0])
+	case len(m.Thumbnails) > 0:
+		return m.Thumbnails[0].URL
+	}
+	return ""
+}
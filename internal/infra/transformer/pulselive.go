@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/SportsNewsCrawler/internal/domain"
@@ -29,13 +30,40 @@ type PulseLiveArticle struct {
 	Date         string `json:"date"`
 	LastModified int64  `json:"lastModified"`
 	CanonicalURL string `json:"canonicalUrl"`
+	Author       string `json:"author"`   // Byline, e.g. "Jane Doe and John Smith"
+	Language     string `json:"language"` // e.g. "EN"
 	Tags         []struct {
 		ID    int    `json:"id"`
 		Label string `json:"label"`
 	} `json:"tags"`
-	LeadMedia struct {
-		ImageURL string `json:"imageUrl"`
-	} `json:"leadMedia"`
+	LeadMedia  *PulseLiveMedia `json:"leadMedia"`
+	References []struct {
+		Type string `json:"type"` // e.g. "CRICKET_MATCH"
+		ID   int    `json:"id"`
+	} `json:"references"`
+}
+
+// PulseLiveMedia is a photo, video or gallery content item.
+type PulseLiveMedia struct {
+	ID              int    `json:"id"`
+	Type            string `json:"type"` // "photo", "video" or "gallery"
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	Copyright       string `json:"copyright"`
+	ImageURL        string `json:"imageUrl"`
+	OnDemandURL     string `json:"onDemandUrl"`
+	Duration        int    `json:"duration"` // Seconds
+	OriginalDetails struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	} `json:"originalDetails"`
+	Variants []struct {
+		URL    string `json:"url"`
+		Width  int    `json:"width"`
+		Height int    `json:"height"`
+	} `json:"variants"`
+	Thumbnail *PulseLiveMedia  `json:"thumbnail"` // Video poster
+	Items     []PulseLiveMedia `json:"items"`     // Gallery photos
 }
 
 type PulseLiveResponse struct {
@@ -94,6 +122,25 @@ func (t *PulseLiveTransformer) normalize(pa PulseLiveArticle) domain.Article {
 		}
 	}
 
+	var media []domain.Media
+	imageURL := ""
+	if pa.LeadMedia != nil {
+		if m, ok := pulseLiveMedia(*pa.LeadMedia); ok {
+			media = append(media, m)
+		}
+		imageURL = pa.LeadMedia.ImageURL
+	}
+	if imageURL == "" && len(media) > 0 {
+		imageURL = leadImageURL(media[0])
+	}
+
+	var matchIDs []string
+	for _, ref := range pa.References {
+		if ref.Type == pulseLiveMatchReference {
+			matchIDs = append(matchIDs, pulseLiveMatchID(ref.ID))
+		}
+	}
+
 	return domain.Article{
 		ID:          fmt.Sprintf("%s_%d", PulseLiveName, pa.ID),
 		ExternalID:  fmt.Sprintf("%d", pa.ID),
@@ -106,7 +153,70 @@ func (t *PulseLiveTransformer) normalize(pa PulseLiveArticle) domain.Article {
 		PublishedAt: pubDate,
 		UpdatedAt:   time.Unix(pa.LastModified/1000, 0),
 		URL:         pa.CanonicalURL,
-		ImageURL:    pa.LeadMedia.ImageURL,
+		ImageURL:    imageURL,
 		Tags:        tags,
+		Media:       media,
+		Authors:     parseByline(pa.Author),
+		Language:    strings.ToLower(pa.Language),
+		MatchIDs:    matchIDs,
+	}
+}
+
+// pulseLiveMedia converts a PulseLive media item; ok is false when it has no
+// usable URL.
+func pulseLiveMedia(pm PulseLiveMedia) (domain.Media, bool) {
+	caption := pm.Description
+	if caption == "" {
+		caption = pm.Title
+	}
+	m := domain.Media{
+		Caption: caption,
+		Credit:  pm.Copyright,
+		Width:   pm.OriginalDetails.Width,
+		Height:  pm.OriginalDetails.Height,
+	}
+	for _, v := range pm.Variants {
+		if v.URL != "" {
+			m.Thumbnails = append(m.Thumbnails, domain.Thumbnail{URL: v.URL, Width: v.Width, Height: v.Height})
+		}
+	}
+
+	switch pm.Type {
+	case "video":
+		m.Type = domain.MediaVideo
+		m.URL = pm.OnDemandURL
+		m.Duration = pm.Duration
+		if pm.Thumbnail != nil {
+			if poster, ok := pulseLiveMedia(*pm.Thumbnail); ok {
+				m.Thumbnails = append(m.Thumbnails, domain.Thumbnail{URL: poster.URL, Width: poster.Width, Height: poster.Height})
+				m.Thumbnails = append(m.Thumbnails, poster.Thumbnails...)
+			}
+		} else if pm.ImageURL != "" {
+			m.Thumbnails = append(m.Thumbnails, domain.Thumbnail{URL: pm.ImageURL})
+		}
+		if m.URL == "" && len(m.Thumbnails) == 0 {
+			return domain.Media{}, false
+		}
+	case "gallery":
+		m.Type = domain.MediaGallery
+		for _, item := range pm.Items {
+			if photo, ok := pulseLiveMedia(item); ok {
+				m.Items = append(m.Items, photo)
+			}
+		}
+		if len(m.Items) == 0 {
+			return domain.Media{}, false
+		}
+	default:
+		m.Type = domain.MediaImage
+		m.URL = pm.ImageURL
+		if m.URL == "" {
+			m.URL = pm.OnDemandURL
+		}
+		if m.URL == "" {
+			return domain.Media{}, false
+		}
 	}
+	m.MIMEType = mimeTypeOf(m.URL)
+	return m, true
 }
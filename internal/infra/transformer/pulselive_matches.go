@@ -0,0 +1,167 @@
+package transformer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+const PulseLiveMatchesName = "pulselive-matches"
+
+// pulseLiveMatchReference is the type of article references to cricket matches.
+const pulseLiveMatchReference = "CRICKET_MATCH"
+
+// PulseLiveMatchTeam is one side of a PulseLive schedule entry.
+type PulseLiveMatchTeam struct {
+	Team struct {
+		ID       int    `json:"id"`
+		FullName string `json:"fullName"`
+	} `json:"team"`
+	Innings []struct {
+		Runs     int    `json:"runs"`
+		Wickets  int    `json:"wkts"`
+		Overs    string `json:"overs"`
+		Declared bool   `json:"declared"`
+	} `json:"innings"`
+}
+
+// PulseLiveScheduleEntry is a fixture or result of the PulseLive cricket API.
+type PulseLiveScheduleEntry struct {
+	MatchID struct {
+		ID int `json:"id"`
+	} `json:"matchId"`
+	MatchDate   string `json:"matchDate"`
+	MatchType   string `json:"matchType"`   // e.g. "TEST", "ODI", "T20"
+	Description string `json:"description"` // e.g. "1st Test"
+	MatchState  string `json:"matchState"`  // "U" upcoming, "L" live, "C" complete
+	MatchStatus struct {
+		Text    string `json:"text"`    // e.g. "England won by 45 runs"
+		Outcome string `json:"outcome"` // "A" team 1 won, "B" team 2 won, "D" drawn, "T" tied
+	} `json:"matchStatus"`
+	Team1 PulseLiveMatchTeam `json:"team1"`
+	Team2 PulseLiveMatchTeam `json:"team2"`
+	Venue struct {
+		FullName string `json:"fullName"`
+	} `json:"venue"`
+}
+
+type PulseLiveMatchesResponse struct {
+	PageInfo PageInfo `json:"pageInfo"`
+	Content  []struct {
+		ScheduleEntry   PulseLiveScheduleEntry `json:"scheduleEntry"`
+		TournamentLabel string                 `json:"tournamentLabel"`
+	} `json:"content"`
+}
+
+// PulseLiveMatchTransformer normalizes the fixtures and results of the
+// PulseLive cricket API.
+type PulseLiveMatchTransformer struct{}
+
+func NewPulseLiveMatchTransformer() *PulseLiveMatchTransformer {
+	return &PulseLiveMatchTransformer{}
+}
+
+func (t *PulseLiveMatchTransformer) Transform(reader io.Reader) ([]domain.Match, *domain.PageInfo, error) {
+	var resp PulseLiveMatchesResponse
+	if err := json.NewDecoder(reader).Decode(&resp); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode pulse live matches response: %w", err)
+	}
+
+	matches := make([]domain.Match, 0, len(resp.Content))
+	for _, c := range resp.Content {
+		m := t.normalize(c.ScheduleEntry)
+		m.Competition = c.TournamentLabel
+		matches = append(matches, m)
+	}
+
+	pageInfo := &domain.PageInfo{
+		Page:       resp.PageInfo.Page,
+		NumPages:   resp.PageInfo.NumPages,
+		PageSize:   resp.PageInfo.PageSize,
+		NumEntries: resp.PageInfo.NumEntries,
+	}
+	return matches, pageInfo, nil
+}
+
+func (t *PulseLiveMatchTransformer) normalize(e PulseLiveScheduleEntry) domain.Match {
+	startsAt, _ := time.Parse(time.RFC3339, e.MatchDate)
+	team1, team2 := pulseLiveMatchTeam(e.Team1), pulseLiveMatchTeam(e.Team2)
+
+	title := team1.Name + " v " + team2.Name
+	if e.Description != "" {
+		title += ", " + e.Description
+	}
+
+	m := domain.Match{
+		ID:         pulseLiveMatchID(e.MatchID.ID),
+		Source:     PulseLiveName,
+		ExternalID: strconv.Itoa(e.MatchID.ID),
+		Sport:      "cricket",
+		Title:      title,
+		Venue:      e.Venue.FullName,
+		StartsAt:   startsAt,
+		Status:     pulseLiveMatchStatus(e.MatchState, e.MatchStatus.Text),
+		Teams:      []domain.MatchTeam{team1, team2},
+		Result:     e.MatchStatus.Text,
+		FetchedAt:  time.Now(),
+	}
+	if m.Status == domain.MatchCompleted {
+		switch e.MatchStatus.Outcome {
+		case "A":
+			m.Winner = team1.Name
+		case "B":
+			m.Winner = team2.Name
+		}
+	}
+	return m
+}
+
+func pulseLiveMatchID(id int) string {
+	return fmt.Sprintf("%s_match_%d", PulseLiveName, id)
+}
+
+// pulseLiveMatchTeam converts a side, writing its innings as "325-7",
+// "371" when all out, "401-5d" when declared, joined by " & ".
+func pulseLiveMatchTeam(t PulseLiveMatchTeam) domain.MatchTeam {
+	var innings []string
+	for _, in := range t.Innings {
+		score := strconv.Itoa(in.Runs)
+		if in.Wickets < 10 {
+			score += "-" + strconv.Itoa(in.Wickets)
+		}
+		if in.Declared {
+			score += "d"
+		}
+		innings = append(innings, score)
+	}
+	team := domain.MatchTeam{Name: t.Team.FullName, Score: strings.Join(innings, " & ")}
+	if t.Team.ID != 0 {
+		team.ExternalID = strconv.Itoa(t.Team.ID)
+	}
+	return team
+}
+
+func pulseLiveMatchStatus(state, text string) domain.MatchStatus {
+	lower := strings.ToLower(text)
+	switch {
+	case strings.Contains(lower, "abandoned"):
+		return domain.MatchAbandoned
+	case strings.Contains(lower, "postponed"):
+		return domain.MatchPostponed
+	case strings.Contains(lower, "cancelled"):
+		return domain.MatchCancelled
+	}
+	switch state {
+	case "L":
+		return domain.MatchLive
+	case "C":
+		return domain.MatchCompleted
+	default:
+		return domain.MatchScheduled
+	}
+}
@@ -0,0 +1,68 @@
+package transformer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pulseLiveMatchesSample = `{
+  "pageInfo": {"page": 0, "numPages": 1, "pageSize": 10, "numEntries": 2},
+  "content": [
+    {
+      "tournamentLabel": "West Indies tour of England 2026",
+      "scheduleEntry": {
+        "matchId": {"id": 1001}, "matchDate": "2026-07-10T10:00:00Z", "matchType": "TEST",
+        "description": "1st Test", "matchState": "C",
+        "matchStatus": {"text": "England won by an innings and 114 runs", "outcome": "A"},
+        "team1": {"team": {"id": 1, "fullName": "England"}, "innings": [{"runs": 371, "wkts": 10}]},
+        "team2": {"team": {"id": 5, "fullName": "West Indies"}, "innings": [{"runs": 121, "wkts": 10}, {"runs": 136, "wkts": 10}]},
+        "venue": {"fullName": "Lord's"}
+      }
+    },
+    {
+      "scheduleEntry": {
+        "matchId": {"id": 1002}, "matchDate": "2026-07-18T10:00:00Z", "description": "2nd Test", "matchState": "U",
+        "matchStatus": {"text": "Match postponed"},
+        "team1": {"team": {"id": 1, "fullName": "England"}},
+        "team2": {"team": {"id": 5, "fullName": "West Indies"}, "innings": [{"runs": 416, "wkts": 8, "declared": true}]}
+      }
+    }
+  ]
+}`
+
+func TestPulseLiveMatchTransformer(t *testing.T) {
+	matches, pageInfo, err := NewPulseLiveMatchTransformer().Transform(strings.NewReader(pulseLiveMatchesSample))
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, 1, pageInfo.NumPages)
+
+	played := matches[0]
+	assert.Equal(t, "pulselive_match_1001", played.ID)
+	assert.Equal(t, "England v West Indies, 1st Test", played.Title)
+	assert.Equal(t, "West Indies tour of England 2026", played.Competition)
+	assert.Equal(t, time.Date(2026, 7, 10, 10, 0, 0, 0, time.UTC), played.StartsAt)
+	assert.Equal(t, domain.MatchCompleted, played.Status)
+	assert.Equal(t, "England", played.Winner)
+	assert.Equal(t, []domain.MatchTeam{
+		{Name: "England", ExternalID: "1", Score: "371"},
+		{Name: "West Indies", ExternalID: "5", Score: "121 & 136"},
+	}, played.Teams)
+
+	assert.Equal(t, domain.MatchPostponed, matches[1].Status, "the status text wins over the match state")
+	assert.Empty(t, matches[1].Winner)
+	assert.Equal(t, "416-8d", matches[1].Teams[1].Score)
+}
+
+func TestPulseLiveTransformer_MatchReferences(t *testing.T) {
+	sample := `{"content": [{"id": 7, "title": "Report", "date": "2026-07-12T18:00:00Z",
+		"references": [{"type": "CRICKET_MATCH", "id": 1001}, {"type": "CRICKET_TEAM", "id": 1}]}]}`
+	articles, _, err := NewPulseLiveTransformer().Transform(strings.NewReader(sample))
+	require.NoError(t, err)
+	require.Len(t, articles, 1)
+	assert.Equal(t, []string{"pulselive_match_1001"}, articles[0].MatchIDs)
+}
@@ -0,0 +1,78 @@
+package transformer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pulseLiveMediaSample = `{
+  "pageInfo": {"page": 0, "numPages": 1, "pageSize": 10, "numEntries": 3},
+  "content": [
+    {
+      "id": 1, "type": "text", "title": "Photo story", "date": "2026-07-01T18:00:00Z",
+      "leadMedia": {
+        "type": "photo", "title": "Root celebrates", "description": "Joe Root celebrates his century",
+        "copyright": "Getty Images", "imageUrl": "https://resources.ecb.co.uk/photo/root.jpg",
+        "originalDetails": {"width": 3000, "height": 2000},
+        "variants": [{"url": "https://resources.ecb.co.uk/photo/root-640.jpg", "width": 640, "height": 427}]
+      }
+    },
+    {
+      "id": 2, "type": "text", "title": "Video story", "date": "2026-07-01T18:00:00Z",
+      "leadMedia": {
+        "type": "video", "title": "Highlights", "duration": 185,
+        "onDemandUrl": "https://video.ecb.co.uk/highlights.m3u8",
+        "thumbnail": {"type": "photo", "imageUrl": "https://resources.ecb.co.uk/photo/poster.jpg",
+                      "originalDetails": {"width": 1280, "height": 720}}
+      }
+    },
+    {
+      "id": 3, "type": "text", "title": "Gallery story", "date": "2026-07-01T18:00:00Z",
+      "leadMedia": {
+        "type": "gallery", "title": "Day one in pictures",
+        "items": [
+          {"type": "photo", "imageUrl": "https://resources.ecb.co.uk/photo/1.png", "description": "Toss"},
+          {"type": "photo", "description": "Missing image"}
+        ]
+      }
+    }
+  ]
+}`
+
+func TestPulseLiveTransformer_Media(t *testing.T) {
+	articles, _, err := NewPulseLiveTransformer().Transform(strings.NewReader(pulseLiveMediaSample))
+	require.NoError(t, err)
+	require.Len(t, articles, 3)
+
+	assert.Equal(t, "https://resources.ecb.co.uk/photo/root.jpg", articles[0].ImageURL)
+	assert.Equal(t, []domain.Media{{
+		Type: domain.MediaImage, URL: "https://resources.ecb.co.uk/photo/root.jpg", Width: 3000, Height: 2000,
+		Caption: "Joe Root celebrates his century", Credit: "Getty Images", MIMEType: "image/jpeg",
+		Thumbnails: []domain.Thumbnail{{URL: "https://resources.ecb.co.uk/photo/root-640.jpg", Width: 640, Height: 427}},
+	}}, articles[0].Media)
+
+	assert.Equal(t, "https://resources.ecb.co.uk/photo/poster.jpg", articles[1].ImageURL, "videos are represented by their poster")
+	assert.Equal(t, []domain.Media{{
+		Type: domain.MediaVideo, URL: "https://video.ecb.co.uk/highlights.m3u8", Caption: "Highlights", Duration: 185,
+		MIMEType:   "application/vnd.apple.mpegurl",
+		Thumbnails: []domain.Thumbnail{{URL: "https://resources.ecb.co.uk/photo/poster.jpg", Width: 1280, Height: 720}},
+	}}, articles[1].Media)
+
+	require.Len(t, articles[2].Media, 1)
+	gallery := articles[2].Media[0]
+	assert.Equal(t, domain.MediaGallery, gallery.Type)
+	require.Len(t, gallery.Items, 1, "items without an image are dropped")
+	assert.Equal(t, "image/png", gallery.Items[0].MIMEType)
+	assert.Equal(t, "https://resources.ecb.co.uk/photo/1.png", articles[2].ImageURL)
+}
+
+func TestParseByline(t *testing.T) {
+	assert.Equal(t, []domain.Author{{Name: "Jane Doe"}, {Name: "John Smith"}, {Name: "Ali Khan"}},
+		parseByline("By Jane Doe, John Smith and Ali Khan"))
+	assert.Equal(t, []domain.Author{{Name: "Andrew Miller"}}, parseByline("Andrew Miller"))
+	assert.Nil(t, parseByline("  "))
+}
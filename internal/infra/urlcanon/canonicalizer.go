@@ -0,0 +1,177 @@
+// Package urlcanon rewrites article URLs to a canonical form so the same page
+// reached through tracking links, http, trailing slashes or AMP is recognised.
+package urlcanon
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// DefaultStripParams are tracking parameters that never change the page content.
+var DefaultStripParams = []string{"utm_*", "fbclid", "gclid", "dclid", "msclkid", "mc_cid", "mc_eid", "_ga", "_gl", "igshid", "cmpid", "ito"}
+
+// Rules configure a Canonicalizer.
+type Rules struct {
+	// StripParams are query parameters to remove; a trailing "*" matches a prefix ("utm_*").
+	StripParams       []string
+	ForceHTTPS        bool
+	StripWWW          bool
+	TrimTrailingSlash bool
+	// ResolveAMP maps AMP variants (Google AMP cache, amp. hosts, /amp paths,
+	// amp=1 queries) to the regular page.
+	ResolveAMP bool
+}
+
+// Canonicalizer applies Rules to URLs.
+type Canonicalizer struct {
+	rules    Rules
+	exact    map[string]bool
+	prefixes []string
+}
+
+func New(rules Rules) *Canonicalizer {
+	c := &Canonicalizer{rules: rules, exact: make(map[string]bool)}
+	for _, p := range rules.StripParams {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			c.prefixes = append(c.prefixes, prefix)
+		} else if p != "" {
+			c.exact[p] = true
+		}
+	}
+	return c
+}
+
+// Canonicalize returns the canonical form of an absolute http(s) URL.
+func (c *Canonicalizer) Canonicalize(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", err
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("not an absolute http(s) url: %q", raw)
+	}
+	if c.rules.ResolveAMP {
+		u = resolveAMPCache(u)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if c.rules.ForceHTTPS {
+		u.Scheme = "https"
+		if port == "80" {
+			port = ""
+		}
+	}
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	if c.rules.ResolveAMP {
+		host = strings.TrimPrefix(host, "amp.")
+	}
+	if c.rules.StripWWW {
+		host = strings.TrimPrefix(host, "www.")
+	}
+	u.Host = host
+	if port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	}
+
+	path := u.EscapedPath()
+	if c.rules.ResolveAMP {
+		path = resolveAMPPath(path)
+	}
+	if c.rules.TrimTrailingSlash && len(path) > 1 {
+		path = strings.TrimRight(path, "/")
+	}
+	if path == "" {
+		path = "/"
+	}
+	if err := setEscapedPath(u, path); err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	for key := range query {
+		if c.strip(key) || (c.rules.ResolveAMP && isAMPParam(key, query.Get(key))) {
+			query.Del(key)
+		}
+	}
+	u.RawQuery = query.Encode() // Also sorts the remaining parameters
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.User = nil
+
+	return u.String(), nil
+}
+
+func (c *Canonicalizer) strip(param string) bool {
+	param = strings.ToLower(param)
+	if c.exact[param] {
+		return true
+	}
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(param, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAMPCache maps a Google AMP cache URL such as
+// https://www-example-com.cdn.ampproject.org/c/s/www.example.com/news to the origin URL.
+func resolveAMPCache(u *url.URL) *url.URL {
+	if !strings.HasSuffix(strings.ToLower(u.Hostname()), ".cdn.ampproject.org") {
+		return u
+	}
+	rest := strings.TrimPrefix(u.EscapedPath(), "/")
+	kind, rest, ok := strings.Cut(rest, "/")
+	if !ok || (kind != "c" && kind != "v") {
+		return u
+	}
+	scheme := "http"
+	if after, ok := strings.CutPrefix(rest, "s/"); ok {
+		scheme, rest = "https", after
+	}
+	origin, err := url.Parse(scheme + "://" + rest)
+	if err != nil || origin.Host == "" {
+		return u
+	}
+	origin.RawQuery = u.RawQuery
+	return origin
+}
+
+// resolveAMPPath removes AMP markers from a path: a trailing or leading /amp
+// segment and the .amp.html extension.
+func resolveAMPPath(path string) string {
+	switch {
+	case strings.HasSuffix(path, ".amp.html"):
+		return strings.TrimSuffix(path, ".amp.html") + ".html"
+	case strings.HasSuffix(path, "/amp") || strings.HasSuffix(path, "/amp/"):
+		return strings.TrimSuffix(strings.TrimSuffix(path, "/"), "/amp")
+	case strings.HasPrefix(path, "/amp/"):
+		return strings.TrimPrefix(path, "/amp")
+	}
+	return path
+}
+
+func isAMPParam(key, value string) bool {
+	key, value = strings.ToLower(key), strings.ToLower(value)
+	return (key == "amp" && (value == "" || value == "1" || value == "true")) || (key == "outputtype" && value == "amp")
+}
+
+func setEscapedPath(u *url.URL, escaped string) error {
+	path, err := url.PathUnescape(escaped)
+	if err != nil {
+		return err
+	}
+	u.Path = path
+	u.RawPath = ""
+	if path != escaped {
+		u.RawPath = escaped
+	}
+	return nil
+}
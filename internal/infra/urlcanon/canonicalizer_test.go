@@ -0,0 +1,51 @@
+package urlcanon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizer_Canonicalize(t *testing.T) {
+	c := New(Rules{StripParams: DefaultStripParams, ForceHTTPS: true, TrimTrailingSlash: true, ResolveAMP: true})
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"tracking params", "https://www.ecb.co.uk/news/4031?utm_source=twitter&utm_medium=social&fbclid=abc", "https://www.ecb.co.uk/news/4031"},
+		{"kept params sorted", "https://www.ecb.co.uk/search?q=root&page=2&utm_campaign=x", "https://www.ecb.co.uk/search?page=2&q=root"},
+		{"http and host case", "HTTP://WWW.ECB.co.uk:80/News/4031/", "https://www.ecb.co.uk/News/4031"},
+		{"fragment", "https://www.ecb.co.uk/news/4031#comments", "https://www.ecb.co.uk/news/4031"},
+		{"root path", "https://www.ecb.co.uk", "https://www.ecb.co.uk/"},
+		{"amp suffix", "https://www.ecb.co.uk/news/4031/england-win/amp", "https://www.ecb.co.uk/news/4031/england-win"},
+		{"amp prefix", "https://www.ecb.co.uk/amp/news/4031", "https://www.ecb.co.uk/news/4031"},
+		{"amp html", "https://www.example.com/sport/report.amp.html", "https://www.example.com/sport/report.html"},
+		{"amp host and query", "https://amp.example.com/sport/report?amp=1", "https://example.com/sport/report"},
+		{"amp cache", "https://www-ecb-co-uk.cdn.ampproject.org/c/s/www.ecb.co.uk/news/4031/amp", "https://www.ecb.co.uk/news/4031"},
+		{"escaped path kept", "https://www.ecb.co.uk/news/lord%27s-test", "https://www.ecb.co.uk/news/lord%27s-test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Canonicalize(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCanonicalizer_RulesOff(t *testing.T) {
+	got, err := New(Rules{}).Canonicalize("http://www.ecb.co.uk/news/4031/amp/?utm_source=x")
+	require.NoError(t, err)
+	assert.Equal(t, "http://www.ecb.co.uk/news/4031/amp/?utm_source=x", got)
+}
+
+func TestCanonicalizer_Invalid(t *testing.T) {
+	c := New(Rules{})
+	for _, in := range []string{"", "/news/4031", "ftp://ecb.co.uk/file", "https://"} {
+		_, err := c.Canonicalize(in)
+		assert.Error(t, err, in)
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/gorilla/mux"
+)
+
+// ArticleHandler exposes stored article data under /articles.
+type ArticleHandler struct {
+	service *app.ArticleService
+}
+
+func NewArticleHandler(service *app.ArticleService) *ArticleHandler {
+	return &ArticleHandler{service: service}
+}
+
+func (h *ArticleHandler) RegisterRoutes(r *mux.Router) {
+	s := r.PathPrefix("/articles").Subrouter()
+	s.HandleFunc("", h.listArticles).Methods("GET")
+	s.HandleFunc("/{id}", h.getArticle).Methods("GET")
+	s.HandleFunc("/{id}/lifecycle", h.listTransitions).Methods("GET")
+	s.HandleFunc("/{id}/revisions", h.listRevisions).Methods("GET")
+	s.HandleFunc("/{id}/variants", h.listVariants).Methods("GET")
+}
+
+// listArticles returns the articles in the ?state= lifecycle state, most
+// recently changed first (?limit=, default 100).
+func (h *ArticleHandler) listArticles(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", 100)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	articles, err := h.service.ListByState(r.Context(), domain.LifecycleState(r.URL.Query().Get("state")), limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, articles)
+}
+
+// getArticle returns a stored article with its editorial overrides applied.
+func (h *ArticleHandler) getArticle(w http.ResponseWriter, r *http.Request) {
+	article, err := h.service.GetArticle(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, article)
+}
+
+// listRevisions returns an article's prior versions and what changed, newest first (?limit=, default 20).
+func (h *ArticleHandler) listRevisions(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", 20)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	revisions, err := h.service.ListRevisions(r.Context(), mux.Vars(r)["id"], limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, revisions)
+}
+
+// listTransitions returns an article's lifecycle transitions, newest first (?limit=, default 50).
+func (h *ArticleHandler) listTransitions(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", 50)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	transitions, err := h.service.ListTransitions(r.Context(), mux.Vars(r)["id"], limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, transitions)
+}
+
+// listVariants returns the language versions of an article, itself included.
+func (h *ArticleHandler) listVariants(w http.ResponseWriter, r *http.Request) {
+	variants, err := h.service.ListVariants(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, variants)
+}
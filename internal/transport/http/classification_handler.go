@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/gorilla/mux"
+)
+
+// ClassificationHandler exposes the classifier dry run under /admin/classifier.
+type ClassificationHandler struct {
+	service *app.ClassificationService
+}
+
+func NewClassificationHandler(service *app.ClassificationService) *ClassificationHandler {
+	return &ClassificationHandler{service: service}
+}
+
+func (h *ClassificationHandler) RegisterRoutes(r *mux.Router) {
+	s := r.PathPrefix("/admin/classifier").Subrouter()
+	s.HandleFunc("/dry-run", h.dryRun).Methods("POST")
+	s.HandleFunc("/dry-run/{id}", h.dryRunStored).Methods("GET")
+}
+
+// dryRun classifies the article in the request body and returns the scores and the rules that fired.
+func (h *ClassificationHandler) dryRun(w http.ResponseWriter, r *http.Request) {
+	var article domain.Article
+	if err := readJSON(w, r, &article); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, h.service.DryRun(&article))
+}
+
+// dryRunStored classifies a stored article with the current rules.
+func (h *ClassificationHandler) dryRunStored(w http.ResponseWriter, r *http.Request) {
+	result, err := h.service.DryRunStored(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/gorilla/mux"
+)
+
+// EmbargoHandler exposes the embargoed articles under /admin/embargoes.
+type EmbargoHandler struct {
+	scheduler *app.EmbargoScheduler
+}
+
+func NewEmbargoHandler(scheduler *app.EmbargoScheduler) *EmbargoHandler {
+	return &EmbargoHandler{scheduler: scheduler}
+}
+
+func (h *EmbargoHandler) RegisterRoutes(r *mux.Router) {
+	s := r.PathPrefix("/admin/embargoes").Subrouter()
+	s.HandleFunc("", h.listHeld).Methods("GET")
+	s.HandleFunc("/{id}/release", h.release).Methods("POST")
+}
+
+// listHeld returns the articles waiting for their embargo to end, earliest first (?limit=, default 100).
+func (h *EmbargoHandler) listHeld(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", 100)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	articles, err := h.scheduler.ListHeld(r.Context(), limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, articles)
+}
+
+// release publishes an embargoed article's event now.
+func (h *EmbargoHandler) release(w http.ResponseWriter, r *http.Request) {
+	article, err := h.scheduler.Release(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, article)
+}
@@ -0,0 +1,87 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/domain"
+)
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Error("Failed to write response", "error", err)
+	}
+}
+
+// writeError maps domain errors to status codes; anything else is a 500.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.As(err, new(*http.MaxBytesError)):
+		status = http.StatusRequestEntityTooLarge
+	case errors.Is(err, domain.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidInput):
+		status = http.StatusBadRequest
+	default:
+		slog.Error("Request failed", "error", err)
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// maxBodyBytes caps request bodies; the largest are articles sent to the
+// classifier dry run.
+const maxBodyBytes = 2 << 20
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return errors.Join(domain.ErrInvalidInput, err)
+	}
+	return nil
+}
+
+// queryInt returns the integer query parameter key, or def when it is absent.
+func queryInt(r *http.Request, key string, def int) (int, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		return 0, errors.Join(domain.ErrInvalidInput, errors.New(key+" must be a non-negative integer"))
+	}
+	return n, nil
+}
+
+// queryDuration returns the duration query parameter key (e.g. "24h"), or def when it is absent.
+func queryDuration(r *http.Request, key string, def time.Duration) (time.Duration, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d < 0 {
+		return 0, errors.Join(domain.ErrInvalidInput, errors.New(key+" must be a positive duration such as 24h"))
+	}
+	return d, nil
+}
+
+func queryTime(r *http.Request, key string) (time.Time, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, errors.Join(domain.ErrInvalidInput, errors.New(key+" must be an RFC 3339 time such as 2024-07-10T10:00:00Z"))
+	}
+	return t, nil
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/gorilla/mux"
+)
+
+// MatchHandler exposes stored fixtures and results under /matches.
+type MatchHandler struct {
+	service *app.MatchService
+}
+
+func NewMatchHandler(service *app.MatchService) *MatchHandler {
+	return &MatchHandler{service: service}
+}
+
+func (h *MatchHandler) RegisterRoutes(r *mux.Router) {
+	s := r.PathPrefix("/matches").Subrouter()
+	s.HandleFunc("", h.listMatches).Methods("GET")
+	s.HandleFunc("/{id}", h.getMatch).Methods("GET")
+	s.HandleFunc("/{id}/articles", h.listArticles).Methods("GET")
+}
+
+// listMatches returns matches by start time (?from=&to= RFC 3339, ?team=, ?limit=, default 50).
+func (h *MatchHandler) listMatches(w http.ResponseWriter, r *http.Request) {
+	from, err := queryTime(r, "from")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	to, err := queryTime(r, "to")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	limit, err := queryInt(r, "limit", 50)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	matches, err := h.service.FindMatches(r.Context(), domain.MatchQuery{
+		From:  from,
+		To:    to,
+		Team:  r.URL.Query().Get("team"),
+		Limit: limit,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, matches)
+}
+
+func (h *MatchHandler) getMatch(w http.ResponseWriter, r *http.Request) {
+	match, err := h.service.GetMatch(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, match)
+}
+
+// listArticles returns the articles linked to a match, newest first (?limit=, default 20).
+func (h *MatchHandler) listArticles(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", 20)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	articles, err := h.service.ListArticles(r.Context(), mux.Vars(r)["id"], limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, articles)
+}
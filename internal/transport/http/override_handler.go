@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/gorilla/mux"
+)
+
+// OverrideHandler exposes the editorial overrides under /admin/overrides.
+type OverrideHandler struct {
+	service *app.OverrideService
+}
+
+func NewOverrideHandler(service *app.OverrideService) *OverrideHandler {
+	return &OverrideHandler{service: service}
+}
+
+func (h *OverrideHandler) RegisterRoutes(r *mux.Router) {
+	s := r.PathPrefix("/admin/overrides").Subrouter()
+	s.HandleFunc("/{id}", h.getOverride).Methods("GET")
+	s.HandleFunc("/{id}", h.setOverride).Methods("PATCH")
+	s.HandleFunc("/{id}", h.clearOverride).Methods("DELETE")
+	s.HandleFunc("/{id}/audit", h.listAudit).Methods("GET")
+}
+
+// overrideRequest sets the given fields of an article's override.
+type overrideRequest struct {
+	Fields domain.OverrideFields `json:"fields"`
+	Editor string                `json:"editor"`
+	Reason string                `json:"reason"`
+}
+
+func (h *OverrideHandler) getOverride(w http.ResponseWriter, r *http.Request) {
+	override, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, override)
+}
+
+// setOverride overrides the fields in the body, keeping the article's other overrides.
+func (h *OverrideHandler) setOverride(w http.ResponseWriter, r *http.Request) {
+	var req overrideRequest
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	override, err := h.service.Set(r.Context(), mux.Vars(r)["id"], req.Fields, req.Editor, req.Reason)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, override)
+}
+
+// clearOverride restores the crawled values of the ?field= fields, or all of
+// them (?editor=, ?reason= are audited).
+func (h *OverrideHandler) clearOverride(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	override, err := h.service.Clear(r.Context(), mux.Vars(r)["id"], q["field"], q.Get("editor"), q.Get("reason"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, override)
+}
+
+// listAudit returns the changes to an article's overrides, newest first (?limit=, default 50).
+func (h *OverrideHandler) listAudit(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", 50)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	entries, err := h.service.ListAudit(r.Context(), mux.Vars(r)["id"], limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
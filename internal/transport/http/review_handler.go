@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/gorilla/mux"
+)
+
+// ReviewHandler exposes the human review queue under /admin/reviews.
+type ReviewHandler struct {
+	queue *app.ReviewQueue
+}
+
+func NewReviewHandler(queue *app.ReviewQueue) *ReviewHandler {
+	return &ReviewHandler{queue: queue}
+}
+
+func (h *ReviewHandler) RegisterRoutes(r *mux.Router) {
+	s := r.PathPrefix("/admin/reviews").Subrouter()
+	s.HandleFunc("", h.listPending).Methods("GET")
+	s.HandleFunc("/{id}/approve", h.approve).Methods("POST")
+	s.HandleFunc("/{id}/reject", h.reject).Methods("POST")
+}
+
+// reviewRequest records who decided and why.
+type reviewRequest struct {
+	Reviewer string `json:"reviewer"`
+	Note     string `json:"note"`
+}
+
+// listPending returns the articles awaiting review, oldest first (?limit=, default 100).
+func (h *ReviewHandler) listPending(w http.ResponseWriter, r *http.Request) {
+	limit, err := queryInt(r, "limit", 100)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	articles, err := h.queue.ListPending(r.Context(), limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, articles)
+}
+
+// approve publishes a pending article's event.
+func (h *ReviewHandler) approve(w http.ResponseWriter, r *http.Request) {
+	var req reviewRequest
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	article, err := h.queue.Approve(r.Context(), mux.Vars(r)["id"], req.Reviewer, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, article)
+}
+
+// reject keeps a pending article from ever being published.
+func (h *ReviewHandler) reject(w http.ResponseWriter, r *http.Request) {
+	var req reviewRequest
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	article, err := h.queue.Reject(r.Context(), mux.Vars(r)["id"], req.Reviewer, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, article)
+}
@@ -9,7 +9,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func NewHTTPServer(cfg *config.Config) *http.Server {
+// RouteRegistrar adds a group of endpoints to the server's router.
+type RouteRegistrar interface {
+	RegisterRoutes(r *mux.Router)
+}
+
+func NewHTTPServer(cfg *config.Config, registrars []RouteRegistrar) *http.Server {
 	r := mux.NewRouter()
 	r.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -20,6 +25,10 @@ func NewHTTPServer(cfg *config.Config) *http.Server {
 	}).Methods("GET")
 	r.Handle("/metrics", promhttp.Handler())
 
+	for _, registrar := range registrars {
+		registrar.RegisterRoutes(r)
+	}
+
 	return &http.Server{
 		Addr:    ":" + cfg.ServerPort,
 		Handler: r,
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/SportsNewsCrawler/internal/app"
+	"github.com/SportsNewsCrawler/internal/domain"
+	"github.com/gorilla/mux"
+)
+
+// TaxonomyHandler exposes the tag taxonomy admin API under /admin/taxonomy.
+type TaxonomyHandler struct {
+	service *app.TaxonomyService
+}
+
+func NewTaxonomyHandler(service *app.TaxonomyService) *TaxonomyHandler {
+	return &TaxonomyHandler{service: service}
+}
+
+func (h *TaxonomyHandler) RegisterRoutes(r *mux.Router) {
+	s := r.PathPrefix("/admin/taxonomy").Subrouter()
+	s.HandleFunc("/tags", h.listTags).Methods("GET")
+	s.HandleFunc("/tags/{id}", h.putTag).Methods("PUT")
+	s.HandleFunc("/mappings", h.listMappings).Methods("GET")
+	s.HandleFunc("/mappings", h.createMapping).Methods("POST")
+	s.HandleFunc("/mappings/{id}", h.deleteMapping).Methods("DELETE")
+	s.HandleFunc("/unmapped", h.listUnmapped).Methods("GET")
+}
+
+func (h *TaxonomyHandler) listTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.service.ListCanonicalTags(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, tags)
+}
+
+func (h *TaxonomyHandler) putTag(w http.ResponseWriter, r *http.Request) {
+	var tag domain.CanonicalTag
+	if err := readJSON(w, r, &tag); err != nil {
+		writeError(w, err)
+		return
+	}
+	tag.ID = mux.Vars(r)["id"]
+	if err := h.service.PutCanonicalTag(r.Context(), tag); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (h *TaxonomyHandler) listMappings(w http.ResponseWriter, r *http.Request) {
+	mappings, err := h.service.ListMappings(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, mappings)
+}
+
+func (h *TaxonomyHandler) createMapping(w http.ResponseWriter, r *http.Request) {
+	var mapping domain.TagMapping
+	if err := readJSON(w, r, &mapping); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := h.service.SaveMapping(r.Context(), &mapping); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, mapping)
+}
+
+func (h *TaxonomyHandler) deleteMapping(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeleteMapping(r.Context(), mux.Vars(r)["id"]); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listUnmapped reports source tags with no mapping, seen within ?since= (default 24h), most frequent first.
+func (h *TaxonomyHandler) listUnmapped(w http.ResponseWriter, r *http.Request) {
+	since, err := queryDuration(r, "since", 24*time.Hour)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	limit, err := queryInt(r, "limit", 100)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	tags, err := h.service.ListUnmapped(r.Context(), time.Now().Add(-since), limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, tags)
+}
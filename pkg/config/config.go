@@ -24,6 +24,125 @@ type SourceConfig struct {
 	URL         string           `json:"url"`
 	Transformer string           `json:"transformer"`
 	Pagination  PaginationConfig `json:"pagination"`
+	// MaxCrawlDuration caps a single crawl run. Zero falls back to Config.MaxCrawlDuration.
+	MaxCrawlDuration Duration `json:"max_crawl_duration"`
+	// Enrichers overrides the default enrichment chain. Omit to use ENRICHERS;
+	// an empty list disables enrichment for the source.
+	Enrichers []EnricherConfig `json:"enrichers"`
+	// StripParams are extra query parameters removed from the source's article
+	// URLs, on top of URL_STRIP_PARAMS.
+	StripParams []string `json:"strip_params"`
+	// HashFields overrides HASH_FIELDS, the article fields whose changes are
+	// published as updates.
+	HashFields []string `json:"hash_fields"`
+	// Rights overrides the authorship and rights metadata of the source's
+	// articles; empty fields keep what the feed supplies.
+	Rights RightsConfig `json:"rights"`
+	// Language is the ISO 639-1 code of the source's articles, overriding the
+	// feed and detection. Translated feeds of a publisher should set it.
+	Language string `json:"language"`
+	// Kind is what the source lists: "articles" (default) or "matches" for
+	// fixtures and results.
+	Kind string `json:"kind"`
+	// Filters drop unwanted articles before persistence, in order.
+	Filters []FilterRuleConfig `json:"filters"`
+	// Precedence ranks the source's trust: when sources carry the same story,
+	// the highest ranked one's article is the primary. Defaults to 0.
+	Precedence int `json:"precedence"`
+}
+
+// ContentRuleConfig names a rule matching articles on their content. It
+// matches an article when all the conditions it sets match.
+type ContentRuleConfig struct {
+	Name       string   `json:"name"`
+	Types      []string `json:"types"`
+	Tags       []string `json:"tags"` // Tag labels, ignoring case, or IDs
+	TitleRegex string   `json:"title_regex"`
+	BodyRegex  string   `json:"body_regex"`
+	URLRegex   string   `json:"url_regex"`
+	OlderThan  Duration `json:"older_than"` // Published longer ago than this
+}
+
+// ReviewRuleConfig sends the articles it matches to human review.
+type ReviewRuleConfig struct {
+	ContentRuleConfig
+	Sources []string `json:"sources"` // Source names the rule applies to; empty for all
+}
+
+// FilterRuleConfig is one of a source's content filter rules: "block" rules
+// drop the articles they match and "allow" rules those they do not.
+type FilterRuleConfig struct {
+	ContentRuleConfig
+	Action string `json:"action"` // "allow" or "block"
+}
+
+const (
+	SourceArticles = "articles"
+	SourceMatches  = "matches"
+)
+
+// IsMatchSource reports whether the source lists fixtures and results.
+func (s *SourceConfig) IsMatchSource() bool {
+	return s.Kind == SourceMatches
+}
+
+// RightsConfig is a source's authorship and rights metadata.
+type RightsConfig struct {
+	Authors         []string `json:"authors"`
+	CopyrightHolder string   `json:"copyright_holder"`
+	License         string   `json:"license"`
+	Attribution     string   `json:"attribution"`
+	Restrictions    []string `json:"restrictions"` // Syndication restrictions
+	// EmbargoDelay embargoes articles this long after their publication.
+	EmbargoDelay Duration `json:"embargo_delay"`
+}
+
+// EnricherConfig is one step of a source's enrichment chain.
+type EnricherConfig struct {
+	Name    string `json:"name"`
+	OnError string `json:"on_error"` // "skip" (default), "drop" or "fail"
+}
+
+// Duration is a time.Duration that decodes from JSON as either a duration
+// string (e.g. "5m", "90s") or a number of seconds (e.g. 1.5).
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch v := raw.(type) {
+	case string:
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", v, err)
+		}
+		*d = Duration(parsed)
+	case float64:
+		*d = Duration(time.Duration(v * float64(time.Second)))
+	case nil:
+		*d = 0
+	default:
+		return fmt.Errorf("invalid duration: %s", string(data))
+	}
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// ValidationConfig configures the article validation stage.
+type ValidationConfig struct {
+	Enabled              bool
+	RequiredFields       []string
+	MaxTitleLength       int
+	MaxSummaryLength     int
+	MaxBodyLength        int
+	MaxFutureSkew        time.Duration
+	MaxAge               time.Duration
+	QuarantineCollection string
 }
 
 type Config struct {
@@ -39,6 +158,132 @@ type Config struct {
 	KafkaTopic      string
 	KafkaDLQTopic   string
 	SourcesFilePath string
+	// MaxCrawlDuration is the default time budget for a single provider crawl.
+	MaxCrawlDuration time.Duration
+	Validation       ValidationConfig
+	Enrichment       EnrichmentConfig
+	Duplicates       DuplicateConfig
+	Stories          StoryConfig
+	URLs             URLConfig
+	Revisions        RevisionConfig
+	// HashFields are the article fields hashed to detect updates; nil uses the built-in set.
+	HashFields []string
+	Removals   RemovalConfig
+	// EmbargoScan is how often embargoed articles are checked for release.
+	EmbargoScan time.Duration
+	Language    LanguageConfig
+	Matches     MatchConfig
+	Overrides   OverrideConfig
+	Review      ReviewConfig
+	Lifecycle   LifecycleConfig
+}
+
+// LifecycleConfig configures the article lifecycle tracking.
+type LifecycleConfig struct {
+	Collection string // Transition audit trail
+}
+
+// ReviewConfig configures the human review queue.
+type ReviewConfig struct {
+	RulesFile       string
+	Rules           []ReviewRuleConfig // Loaded from RulesFile
+	MetricsInterval time.Duration      // How often the queue size and age are measured
+}
+
+// OverrideConfig configures the editorial overrides.
+type OverrideConfig struct {
+	Collection      string
+	AuditCollection string
+}
+
+// MatchConfig configures fixtures and results ingestion.
+type MatchConfig struct {
+	Collection string
+	Topic      string        // Kafka topic for new and changed matches
+	LinkWindow time.Duration // Max gap between an article's publication and its match's start; 0 disables linking
+}
+
+// LanguageConfig configures article languages and translation linking.
+type LanguageConfig struct {
+	Primary      string // Articles in other languages get the language appended to their ID
+	LinkVariants bool
+}
+
+// RemovalConfig configures detection of articles removed by their publisher.
+type RemovalConfig struct {
+	Enabled      bool
+	VerifyURLs   bool          // Only withdraw articles whose page answers 404 or 410
+	CheckTimeout time.Duration // Per page check
+	MaxPerCrawl  int           // More missing articles after one crawl are taken as a feed glitch
+}
+
+// RevisionConfig configures the article revision history.
+type RevisionConfig struct {
+	Enabled    bool
+	Collection string
+}
+
+// URLConfig configures article URL canonicalization.
+type URLConfig struct {
+	Enabled           bool
+	StripParams       []string // Nil uses the built-in tracking parameter list
+	ForceHTTPS        bool
+	StripWWW          bool
+	TrimTrailingSlash bool
+	ResolveAMP        bool
+}
+
+// StoryConfig configures story clustering.
+type StoryConfig struct {
+	Enabled    bool
+	Window     time.Duration // Max gap between an article and the story it joins
+	Threshold  float64       // Min similarity, in (0, 1], to join a story
+	Collection string
+	Topic      string // Kafka topic for story-updated events
+}
+
+// DuplicateConfig configures near-duplicate detection.
+type DuplicateConfig struct {
+	Enabled        bool
+	MaxDistance    int           // Max SimHash Hamming distance, 0-3
+	Window         time.Duration // Max publication time gap between duplicates
+	MinWords       int
+	SuppressEvents bool // Skip Kafka events for near duplicates
+	Precedence     bool // Keep the article of the highest ranked source as a story's primary
+	Collection     string
+}
+
+// EnrichmentConfig configures the enrichment chain and the built-in enrichers.
+type EnrichmentConfig struct {
+	// Defaults is the chain used by sources without their own, run with the "skip" failure policy.
+	Defaults              []string
+	ReadingWordsPerMinute int
+	SummarySentences      int // Sentences in summaries generated for articles without one
+	Keywords              int // Keywords extracted per article
+	Gazetteer             GazetteerConfig
+	Taxonomy              TaxonomyConfig
+	Classify              ClassifyConfig
+}
+
+// ClassifyConfig configures the sport and category classifier.
+type ClassifyConfig struct {
+	RulesFile       string
+	MinScore        float64       // Min summed rule weight for a value to be assigned
+	RefreshInterval time.Duration // 0 disables periodic reloads
+}
+
+// GazetteerConfig configures where the entity gazetteer is loaded from.
+type GazetteerConfig struct {
+	Source          string // "file" or "mongo"
+	FilePath        string
+	Collection      string
+	RefreshInterval time.Duration // 0 disables periodic reloads
+}
+
+// TaxonomyConfig configures the tag taxonomy enricher.
+type TaxonomyConfig struct {
+	// RefreshInterval is how often unmapped tags are flushed and mappings reloaded; 0 disables it
+	RefreshInterval time.Duration
 }
 
 func Load() (*Config, error) {
@@ -52,19 +297,111 @@ func Load() (*Config, error) {
 	}
 
 	cfg := &Config{
-		ServerPort:      getEnv("SERVER_PORT", "8080"),
-		MongoDBName:     getEnv("MONGO_DB_NAME", "news_crawler"),
-		MongoColl:       getEnv("MONGO_COLLECTION", "articles"),
-		MongoURI:        getEnv("MONGO_URI", "mongodb://mongodb:27017"),
-		PollInterval:    getDurationEnv("POLL_INTERVAL", 1*time.Minute),
-		BatchSize:       getIntEnv("BATCH_SIZE", 20),
-		WorkerPoolSize:  getIntEnv("WORKER_POOL_SIZE", 5),
-		KafkaBrokers:    strings.Split(brokers, ","),
-		KafkaTopic:      getEnv("KAFKA_TOPIC", "news_articles"),
-		KafkaDLQTopic:   getEnv("KAFKA_DLQ_TOPIC", "news_articles_dlq"),
-		SourcesFilePath: getEnv("SOURCES_FILE_PATH", "config/sources.json"),
+		ServerPort:       getEnv("SERVER_PORT", "8080"),
+		MongoDBName:      getEnv("MONGO_DB_NAME", "news_crawler"),
+		MongoColl:        getEnv("MONGO_COLLECTION", "articles"),
+		MongoURI:         getEnv("MONGO_URI", "mongodb://mongodb:27017"),
+		PollInterval:     getDurationEnv("POLL_INTERVAL", 1*time.Minute),
+		BatchSize:        getIntEnv("BATCH_SIZE", 20),
+		WorkerPoolSize:   getIntEnv("WORKER_POOL_SIZE", 5),
+		KafkaBrokers:     strings.Split(brokers, ","),
+		KafkaTopic:       getEnv("KAFKA_TOPIC", "news_articles"),
+		KafkaDLQTopic:    getEnv("KAFKA_DLQ_TOPIC", "news_articles_dlq"),
+		SourcesFilePath:  getEnv("SOURCES_FILE_PATH", "config/sources.json"),
+		MaxCrawlDuration: getDurationEnv("MAX_CRAWL_DURATION", 5*time.Minute),
+		Enrichment: EnrichmentConfig{
+			Defaults:              getListEnv("ENRICHERS", []string{"sanitize", "language", "entities", "taxonomy", "classify", "results", "summary"}),
+			ReadingWordsPerMinute: getIntEnv("READING_WORDS_PER_MINUTE", 230),
+			SummarySentences:      getIntEnv("SUMMARY_SENTENCES", 2),
+			Keywords:              getIntEnv("KEYWORDS_COUNT", 8),
+			Gazetteer: GazetteerConfig{
+				Source:          getEnv("GAZETTEER_SOURCE", "file"),
+				FilePath:        getEnv("GAZETTEER_FILE_PATH", "config/gazetteer.json"),
+				Collection:      getEnv("MONGO_GAZETTEER_COLLECTION", "gazetteer"),
+				RefreshInterval: getDurationEnv("GAZETTEER_REFRESH_INTERVAL", 10*time.Minute),
+			},
+			Taxonomy: TaxonomyConfig{
+				RefreshInterval: getDurationEnv("TAXONOMY_REFRESH_INTERVAL", 1*time.Minute),
+			},
+			Classify: ClassifyConfig{
+				RulesFile:       getEnv("CLASSIFIER_RULES_FILE", "config/classification.json"),
+				MinScore:        getFloatEnv("CLASSIFIER_MIN_SCORE", 1),
+				RefreshInterval: getDurationEnv("CLASSIFIER_REFRESH_INTERVAL", 1*time.Minute),
+			},
+		},
+		Duplicates: DuplicateConfig{
+			Enabled:        getBoolEnv("DUPLICATES_ENABLED", true),
+			MaxDistance:    getIntEnv("DUPLICATES_MAX_DISTANCE", 3),
+			Window:         getDurationEnv("DUPLICATES_WINDOW", 72*time.Hour),
+			MinWords:       getIntEnv("DUPLICATES_MIN_WORDS", 30),
+			SuppressEvents: getBoolEnv("DUPLICATES_SUPPRESS_EVENTS", true),
+			Precedence:     getBoolEnv("DUPLICATES_PRECEDENCE", true),
+			Collection:     getEnv("MONGO_FINGERPRINT_COLLECTION", "fingerprints"),
+		},
+		Stories: StoryConfig{
+			Enabled:    getBoolEnv("STORIES_ENABLED", true),
+			Window:     getDurationEnv("STORIES_WINDOW", 48*time.Hour),
+			Threshold:  getFloatEnv("STORIES_THRESHOLD", 0.35),
+			Collection: getEnv("MONGO_STORY_COLLECTION", "stories"),
+			Topic:      getEnv("KAFKA_STORY_TOPIC", "news_stories"),
+		},
+		URLs: URLConfig{
+			Enabled:           getBoolEnv("URL_CANONICALIZATION_ENABLED", true),
+			StripParams:       getListEnv("URL_STRIP_PARAMS", nil),
+			ForceHTTPS:        getBoolEnv("URL_FORCE_HTTPS", true),
+			StripWWW:          getBoolEnv("URL_STRIP_WWW", false),
+			TrimTrailingSlash: getBoolEnv("URL_TRIM_TRAILING_SLASH", true),
+			ResolveAMP:        getBoolEnv("URL_RESOLVE_AMP", true),
+		},
+		HashFields: getListEnv("HASH_FIELDS", nil),
+		Removals: RemovalConfig{
+			Enabled:      getBoolEnv("REMOVALS_ENABLED", false),
+			VerifyURLs:   getBoolEnv("REMOVALS_VERIFY_URLS", true),
+			CheckTimeout: getDurationEnv("REMOVALS_CHECK_TIMEOUT", 10*time.Second),
+			MaxPerCrawl:  getIntEnv("REMOVALS_MAX_PER_CRAWL", 50),
+		},
+		EmbargoScan: getDurationEnv("EMBARGO_SCAN_INTERVAL", 30*time.Second),
+		Language: LanguageConfig{
+			Primary:      strings.ToLower(getEnv("LANGUAGE_PRIMARY", "en")),
+			LinkVariants: getBoolEnv("LANGUAGE_LINK_VARIANTS", true),
+		},
+		Matches: MatchConfig{
+			Collection: getEnv("MONGO_MATCH_COLLECTION", "matches"),
+			Topic:      getEnv("KAFKA_MATCH_TOPIC", "news_matches"),
+			LinkWindow: getDurationEnv("MATCH_LINK_WINDOW", 36*time.Hour),
+		},
+		Review: ReviewConfig{
+			RulesFile:       getEnv("REVIEW_RULES_FILE", "config/review.json"),
+			MetricsInterval: getDurationEnv("REVIEW_METRICS_INTERVAL", 30*time.Second),
+		},
+		Lifecycle: LifecycleConfig{
+			Collection: getEnv("MONGO_LIFECYCLE_COLLECTION", "lifecycle_transitions"),
+		},
+		Overrides: OverrideConfig{
+			Collection:      getEnv("MONGO_OVERRIDE_COLLECTION", "article_overrides"),
+			AuditCollection: getEnv("MONGO_OVERRIDE_AUDIT_COLLECTION", "override_audit"),
+		},
+		Revisions: RevisionConfig{
+			Enabled:    getBoolEnv("REVISIONS_ENABLED", true),
+			Collection: getEnv("MONGO_REVISION_COLLECTION", "article_revisions"),
+		},
+		Validation: ValidationConfig{
+			Enabled:              getBoolEnv("VALIDATION_ENABLED", true),
+			RequiredFields:       getListEnv("VALIDATION_REQUIRED_FIELDS", []string{"id", "title", "url", "published_at"}),
+			MaxTitleLength:       getIntEnv("VALIDATION_MAX_TITLE_LENGTH", 500),
+			MaxSummaryLength:     getIntEnv("VALIDATION_MAX_SUMMARY_LENGTH", 5000),
+			MaxBodyLength:        getIntEnv("VALIDATION_MAX_BODY_LENGTH", 1000000),
+			MaxFutureSkew:        getDurationEnv("VALIDATION_MAX_FUTURE_SKEW", 24*time.Hour),
+			MaxAge:               getDurationEnv("VALIDATION_MAX_AGE", 0),
+			QuarantineCollection: getEnv("MONGO_QUARANTINE_COLLECTION", "quarantine"),
+		},
 	}
 	cfg.Sources = loadSources(cfg.SourcesFilePath)
+	rules, err := loadReviewRules(cfg.Review.RulesFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Review.Rules = rules
 
 	if err := cfg.Validate(); err != nil {
 		return nil, err
@@ -121,6 +458,24 @@ func loadSources(path string) []SourceConfig {
 	return validSources
 }
 
+// loadReviewRules reads the review rules; without the file nothing is reviewed.
+func loadReviewRules(path string) ([]ReviewRuleConfig, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		slog.Warn("Review rules file not found, articles are published without review", "path", path)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read review rules: %w", err)
+	}
+
+	var rules []ReviewRuleConfig
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to decode review rules %s: %w", path, err)
+	}
+	return rules, nil
+}
+
 func (s *SourceConfig) Validate() error {
 	if s.Name == "" {
 		return fmt.Errorf("name is required")
@@ -134,6 +489,35 @@ func (s *SourceConfig) Validate() error {
 	if s.Transformer == "" {
 		return fmt.Errorf("transformer is required")
 	}
+	if s.MaxCrawlDuration < 0 {
+		return fmt.Errorf("max_crawl_duration must not be negative")
+	}
+	for _, f := range s.Filters {
+		if f.Name == "" {
+			return fmt.Errorf("filter name is required")
+		}
+		if f.Action != "allow" && f.Action != "block" {
+			return fmt.Errorf("filter %s: action must be allow or block", f.Name)
+		}
+	}
+	switch s.Kind {
+	case "", SourceArticles, SourceMatches:
+	default:
+		return fmt.Errorf("kind must be articles or matches")
+	}
+	if s.Rights.EmbargoDelay < 0 {
+		return fmt.Errorf("rights.embargo_delay must not be negative")
+	}
+	for _, e := range s.Enrichers {
+		if e.Name == "" {
+			return fmt.Errorf("enricher name is required")
+		}
+		switch e.OnError {
+		case "", "skip", "drop", "fail":
+		default:
+			return fmt.Errorf("enricher %s: on_error must be skip, drop or fail", e.Name)
+		}
+	}
 	return nil
 }
 
@@ -144,6 +528,57 @@ func (c *Config) Validate() error {
 	if len(c.KafkaBrokers) == 0 {
 		return fmt.Errorf("KAFKA_BROKERS is required")
 	}
+	if c.MaxCrawlDuration < 0 {
+		return fmt.Errorf("MAX_CRAWL_DURATION must not be negative")
+	}
+	if g := c.Enrichment.Gazetteer; g.Source != "file" && g.Source != "mongo" {
+		return fmt.Errorf("GAZETTEER_SOURCE must be file or mongo, got %q", g.Source)
+	}
+	if e := c.Enrichment; e.SummarySentences < 1 || e.Keywords < 0 {
+		return fmt.Errorf("SUMMARY_SENTENCES must be positive and KEYWORDS_COUNT not negative")
+	}
+	if c.Enrichment.Classify.MinScore <= 0 {
+		return fmt.Errorf("CLASSIFIER_MIN_SCORE must be positive")
+	}
+	if d := c.Duplicates; d.Enabled && (d.MaxDistance < 0 || d.MaxDistance > 3) {
+		return fmt.Errorf("DUPLICATES_MAX_DISTANCE must be between 0 and 3, got %d", d.MaxDistance)
+	}
+	if st := c.Stories; st.Enabled && (st.Threshold <= 0 || st.Threshold > 1) {
+		return fmt.Errorf("STORIES_THRESHOLD must be in (0, 1], got %v", st.Threshold)
+	}
+	if st := c.Stories; st.Enabled && (st.Collection == "" || st.Topic == "") {
+		return fmt.Errorf("MONGO_STORY_COLLECTION and KAFKA_STORY_TOPIC are required when stories are enabled")
+	}
+	if r := c.Removals; r.Enabled && r.MaxPerCrawl < 1 {
+		return fmt.Errorf("REMOVALS_MAX_PER_CRAWL must be positive, got %d", r.MaxPerCrawl)
+	}
+	if l := c.Language; l.LinkVariants && l.Primary == "" {
+		return fmt.Errorf("LANGUAGE_PRIMARY is required to link variants")
+	}
+	if c.EmbargoScan <= 0 {
+		return fmt.Errorf("EMBARGO_SCAN_INTERVAL must be positive")
+	}
+	if m := c.Matches; m.Collection == "" || m.Topic == "" {
+		return fmt.Errorf("MONGO_MATCH_COLLECTION and KAFKA_MATCH_TOPIC are required")
+	}
+	if c.Matches.LinkWindow < 0 {
+		return fmt.Errorf("MATCH_LINK_WINDOW must not be negative")
+	}
+	if o := c.Overrides; o.Collection == "" || o.AuditCollection == "" {
+		return fmt.Errorf("MONGO_OVERRIDE_COLLECTION and MONGO_OVERRIDE_AUDIT_COLLECTION are required")
+	}
+	if c.Lifecycle.Collection == "" {
+		return fmt.Errorf("MONGO_LIFECYCLE_COLLECTION is required")
+	}
+	if c.Review.MetricsInterval <= 0 {
+		return fmt.Errorf("REVIEW_METRICS_INTERVAL must be positive")
+	}
+	if c.Revisions.Collection == "" {
+		return fmt.Errorf("MONGO_REVISION_COLLECTION is required")
+	}
+	if c.Validation.Enabled && c.Validation.QuarantineCollection == "" {
+		return fmt.Errorf("MONGO_QUARANTINE_COLLECTION is required when validation is enabled")
+	}
 	return nil
 }
 
@@ -164,6 +599,41 @@ func getIntEnv(key string, fallback int) int {
 	return fallback
 }
 
+func getFloatEnv(key string, fallback float64) float64 {
+	if value, ok := os.LookupEnv(key); ok {
+		f, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			return f
+		}
+	}
+	return fallback
+}
+
+func getBoolEnv(key string, fallback bool) bool {
+	if value, ok := os.LookupEnv(key); ok {
+		b, err := strconv.ParseBool(value)
+		if err == nil {
+			return b
+		}
+	}
+	return fallback
+}
+
+// getListEnv parses a comma-separated list, trimming blanks. An empty value yields an empty list.
+func getListEnv(key string, fallback []string) []string {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func getDurationEnv(key string, fallback time.Duration) time.Duration {
 	if value, ok := os.LookupEnv(key); ok {
 		// Try parsing as duration string (e.g. "1m", "60s")